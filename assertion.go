@@ -3,25 +3,17 @@ package assertion
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 )
 
 const (
-	errMsgMissingArgs       = `missing required arguments`
-	errMsgNotSameType       = `%v and %v are not of the same type`
-	errMsgNot               = `%v is not %v`
-	errMsgNotEqual          = `%v is not equal %v`
-	errMsgNotValid          = `%v is not a valid %v`
-	errMsgNotGreater        = `%v is not greater than %v`
-	errMsgNotLower          = `%v is not lower than %v`
-	errMsgNotGreaterEqual   = `%v is not greater than or equal %v`
-	errMsgNotLowerEqual     = `%v is not lower than or equal %v`
-	errMsgNotDifferent      = `%v is not different %v`
-	errMsgNotBetween        = `%v is not between %v and %v`
-	errMsgNotBetweenExclude = `%v is not between %v and %v both excluded`
-	errMsgNotStartsWith     = `%v does not start with %v`
-	errMsgNotEndsWith       = `%v does not end with %v`
-	errMsgNotContains       = `%v does not contain %v`
-	errMsgNotHasKey         = `%v has not the key %v`
+	errMsgMissingArgs   = `missing required arguments`
+	errMsgFormat        = `message format %v is not a string`
+	errMsgField         = `%v: %v`
+	errMsgRuleSyntax    = `rule %q at offset %d: %v`
+	errMsgPathSyntax    = `path %q at offset %d: %v`
+	errMsgProblemDetail = `one or more assertions failed`
 )
 
 // Assertion represents a data assertion process. It provides several methods
@@ -31,28 +23,100 @@ const (
 // Every assertion method admits message arguments (msgArgs) on their signature
 // to allow the customization of error messages. If this arguments are provided
 // they will form the error message in case of failure of the corresponding method.
+//
+// By default every failure is recorded. The WithFailFast and WithMaxErrors
+// options limit the number of recorded errors: once the limit is reached, any
+// subsequent assertion is not evaluated and returns false.
+//
+// An Assertion created with New is safe for concurrent use by multiple goroutines.
+// The zero value is ready to use, Go and Wait included, but it is not safe for
+// concurrent use.
 type Assertion struct {
-	errors []error
+	errors     []error
+	onError    func(err error)
+	maxErrors  int
+	locale     string
+	translator Translator
+	sync       *syncState
+}
+
+// syncState holds the synchronization state of an Assertion
+type syncState struct {
+	mu      sync.Mutex
+	tasks   sync.WaitGroup
+	pending []*Assertion
+}
+
+// Option configures an Assertion created with New
+type Option func(a *Assertion)
+
+// New creates and returns a new Assertion configured with the given options
+func New(opts ...Option) Assertion {
+	a := Assertion{errors: make([]error, 0), sync: &syncState{}}
+	for _, opt := range opts {
+		opt(&a)
+	}
+
+	return a
+}
+
+// WithErrorHandler returns an Option that makes the Assertion call a given
+// function every time an error is added, e.g. to report it as soon as it happens
+func WithErrorHandler(fn func(err error)) Option {
+	return func(a *Assertion) {
+		a.onError = fn
+	}
+}
+
+// WithPanic returns an Option that makes the Assertion panic with the error of
+// the first failed assertion, e.g. to check program invariants. It replaces any
+// handler set with WithErrorHandler
+func WithPanic() Option {
+	return WithErrorHandler(func(err error) {
+		panic(err)
+	})
+}
+
+// Must creates and returns a new Assertion that panics on the first failed
+// assertion, e.g. assertion.Must().Email(address)
+func Must(opts ...Option) *Assertion {
+	a := New(append(opts, WithPanic())...)
+	return &a
+}
+
+// WithFailFast returns an Option that makes the Assertion stop evaluating
+// assertions after the first failure
+func WithFailFast() Option {
+	return WithMaxErrors(1)
 }
 
-// New creates and returns a new Assertion
-func New() Assertion {
-	return Assertion{errors: make([]error, 0)}
+// WithMaxErrors returns an Option that makes the Assertion stop evaluating
+// assertions once n errors are recorded. Zero or negative values mean no limit
+func WithMaxErrors(n int) Option {
+	return func(a *Assertion) {
+		a.maxErrors = n
+	}
 }
 
 // HasErrors returns if current Assertion stores some error
 func (a *Assertion) HasErrors() bool {
-	return len(a.errors) > 0
+	return a.CountErrors() > 0
 }
 
 // CountErrors returns the number of current errors
 func (a *Assertion) CountErrors() int {
+	a.lock()
+	defer a.unlock()
+
 	return len(a.errors)
 }
 
 // ErrorAt returns the error at given index. Negative indexes will be considered
 // as reverse order, that is indexes from the last error element
 func (a *Assertion) ErrorAt(index int) error {
+	a.lock()
+	defer a.unlock()
+
 	if index > len(a.errors)-1 {
 		return nil
 	}
@@ -68,28 +132,149 @@ func (a *Assertion) ErrorAt(index int) error {
 	return a.errors[len(a.errors)+index]
 }
 
-// addError adds an error to Assertion
-func (a *Assertion) addError(err error) {
-	a.errors = append(a.errors, err)
+// Err returns nil if there are no errors, otherwise a single error combining
+// the current errors, with their messages joined by semicolons. The combined
+// errors can be retrieved with errors.As or through its Unwrap method
+func (a *Assertion) Err() error {
+	a.lock()
+	defer a.unlock()
+
+	if len(a.errors) == 0 {
+		return nil
+	}
+
+	return append(errorList(nil), a.errors...)
+}
+
+// Fail records a failure with the given message, formatted with args if any,
+// and returns false
+func (a *Assertion) Fail(msg string, args ...interface{}) bool {
+	if len(args) > 0 {
+		msg = fmt.Sprintf(msg, args...)
+	}
+
+	a.addError(errors.New(msg))
+	return false
+}
+
+// AddError records a given error as a failure. Nil errors are ignored
+func (a *Assertion) AddError(err error) {
+	if err != nil {
+		a.addError(err)
+	}
 }
 
-// addErrorMsg adds the default error message to Assertion or a formatted error
-// message if msgArgs are provided
-func (a *Assertion) addErrorMsg(defaultMsg string, msgArgs ...interface{}) {
-	a.errors = append(a.errors, buildError(defaultMsg, msgArgs...))
+// Check returns a given result, recording a failure if it is false. msgArgs
+// customize the error message as in any other assertion method
+func (a *Assertion) Check(ok bool, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		if !ok {
+			a.fail("check", ok, nil, msgArgs...)
+		}
+
+		return ok
+	})
+}
+
+// Merge adds the errors of other Assertion to this one, prefixed by a given path
+// if any, e.g. a.Merge(&address, "address"). It returns true if other Assertion
+// has no errors
+func (a *Assertion) Merge(other *Assertion, path ...string) bool {
+	other.lock()
+	errs := append([]error(nil), other.errors...)
+	other.unlock()
+
+	if len(path) > 0 {
+		a.addFieldErrors(strings.Join(path, "."), errs)
+	} else {
+		for _, err := range errs {
+			a.addError(err)
+		}
+	}
+
+	return len(errs) == 0
+}
+
+// state returns the synchronization state of the Assertion, allocating it on
+// first use for the zero value
+func (a *Assertion) state() *syncState {
+	if a.sync == nil {
+		a.sync = &syncState{}
+	}
+
+	return a.sync
+}
+
+// lock locks the Assertion for exclusive access to its errors
+func (a *Assertion) lock() {
+	if a.sync != nil {
+		a.sync.mu.Lock()
+	}
+}
+
+// unlock unlocks the Assertion locked by lock
+func (a *Assertion) unlock() {
+	if a.sync != nil {
+		a.sync.mu.Unlock()
+	}
+}
+
+// done returns true if the Assertion has reached its errors limit
+func (a *Assertion) done() bool {
+	a.lock()
+	defer a.unlock()
+
+	return a.limitReached()
 }
 
-// buildError returns an error with a default message or a message built from
-// message arguments if msgArgs are provided
-func buildError(defaultMsg string, msgArgs ...interface{}) error {
-	errMsg := defaultMsg
-	if len(msgArgs) == 1 {
-		errMsg = fmt.Sprintf("%+v", msgArgs[0])
+// eval returns the result of a given assertion, or false without evaluating it
+// if the Assertion has reached its errors limit
+func (a *Assertion) eval(fn func() bool) bool {
+	if a.done() {
+		return false
+	}
+
+	return fn()
+}
+
+// limitReached returns true if the errors limit is reached. The Assertion must
+// be locked by the caller
+func (a *Assertion) limitReached() bool {
+	return a.maxErrors > 0 && len(a.errors) >= a.maxErrors
+}
+
+// addError adds an error to Assertion unless its errors limit is reached. Errors
+// of failed assertions get the locale of the Assertion unless they have one
+func (a *Assertion) addError(err error) {
+	if e, ok := err.(*Error); ok && e.locale == "" && (a.locale != "" || a.translator != nil) {
+		c := *e
+		c.locale, c.translator = a.locale, a.translator
+		err = &c
 	}
 
-	if len(msgArgs) > 1 {
-		errMsg = fmt.Sprintf(msgArgs[0].(string), msgArgs[1:]...)
+	a.lock()
+	if a.limitReached() {
+		a.unlock()
+		return
 	}
 
-	return errors.New(errMsg)
+	a.errors = append(a.errors, err)
+	a.unlock()
+
+	if a.onError != nil {
+		a.onError(err)
+	}
+}
+
+// addFieldErrors adds the given errors to Assertion prefixed by a field name
+func (a *Assertion) addFieldErrors(field string, errs []error) {
+	for _, err := range errs {
+		a.addError(newFieldError(field, err))
+	}
+}
+
+// fail adds the error of a failed assertion of a given rule over a value, with
+// the given rule parameters by name. msgArgs customize the error message
+func (a *Assertion) fail(rule string, value interface{}, params map[string]interface{}, msgArgs ...interface{}) {
+	a.addError(NewError(rule, value, params, msgArgs...))
 }
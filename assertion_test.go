@@ -1,6 +1,7 @@
 package assertion
 
 import (
+	"errors"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"reflect"
@@ -29,6 +30,152 @@ func TestAssertion_ErrorAt(t *testing.T) {
 	assert.Nil(t, a.ErrorAt(-3))
 }
 
+func TestAssertion_WithErrorHandler(t *testing.T) {
+	var handled []error
+	a := New(WithErrorHandler(func(err error) {
+		handled = append(handled, err)
+	}))
+
+	a.GreaterThan(2, 1)
+	a.GreaterThan(1, 1)
+	a.Email("plainaddress")
+
+	assert.Equal(t, 2, a.CountErrors())
+	assert.Len(t, handled, 2)
+	assert.EqualError(t, handled[0], "1 is not greater than 1")
+	assert.EqualError(t, handled[1], "plainaddress is not a valid email")
+}
+
+func TestAssertion_WithFailFast(t *testing.T) {
+	a := New(WithFailFast())
+
+	assert.True(t, a.GreaterThan(2, 1))
+	assert.False(t, a.GreaterThan(1, 1))
+	assert.False(t, a.GreaterThan(2, 1))
+	assert.False(t, a.Email("test@mail.com"))
+	assert.False(t, GreaterThan(&a, 2, 1))
+	assert.False(t, a.That("age", 2).GreaterThan(1).Valid())
+	assert.False(t, a.Any([]CheckFunc{func(a *Assertion) bool { return true }}))
+
+	assert.Equal(t, 1, a.CountErrors())
+	assert.EqualError(t, a.ErrorAt(0), "1 is not greater than 1")
+}
+
+func TestAssertion_WithMaxErrors(t *testing.T) {
+	a := New(WithMaxErrors(2))
+
+	assert.False(t, a.Email("a"))
+	assert.True(t, a.Email("test@mail.com"))
+	assert.False(t, Each(&a, []int{0, 1, -1}, func(a *Assertion, v int) bool {
+		return GreaterThan(a, v, 0)
+	}))
+	assert.False(t, a.Email("test@mail.com"))
+
+	assert.Equal(t, 2, a.CountErrors())
+	assert.EqualError(t, a.ErrorAt(0), "a is not a valid email")
+	assert.EqualError(t, a.ErrorAt(1), "[0]: 0 is not greater than 0")
+}
+
+func TestAssertion_WithMaxErrors_Unlimited(t *testing.T) {
+	a := New(WithMaxErrors(0))
+
+	for i := 0; i < 10; i++ {
+		a.GreaterThan(i, 10)
+	}
+
+	assert.Equal(t, 10, a.CountErrors())
+}
+
+func TestAssertion_Must(t *testing.T) {
+	assert.True(t, Must().Email("test@mail.com"))
+	assert.True(t, Must().That("port", 80).Between(1, 65535).Valid())
+
+	assert.PanicsWithError(t, "plainaddress is not a valid email", func() {
+		Must().Email("plainaddress")
+	})
+	assert.PanicsWithError(t, "port: 0 is not between 1 and 65535", func() {
+		Must().That("port", 0).Between(1, 65535)
+	})
+	assert.PanicsWithError(t, "invalid port", func() {
+		a := New(WithPanic())
+		a.Between(0, 1, 65535, "invalid port")
+	})
+}
+
+func TestAssertion_Err(t *testing.T) {
+	a := New()
+	assert.NoError(t, a.Err())
+
+	a.That("age", 200).Between(0, 150)
+	a.Email("foo")
+
+	err := a.Err()
+	assert.EqualError(t, err, "age: 200 is not between 0 and 150; foo is not a valid email")
+
+	var e *Error
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, "between", e.Rule)
+}
+
+func TestAssertion_Fail(t *testing.T) {
+	a := New()
+
+	assert.False(t, a.Fail("invalid account"))
+	assert.False(t, a.Fail("invalid account %s", "ACC-1"))
+	assert.False(t, a.Fail("100% invalid"))
+
+	assert.Equal(t, 3, a.CountErrors())
+	assert.EqualError(t, a.ErrorAt(0), "invalid account")
+	assert.EqualError(t, a.ErrorAt(1), "invalid account ACC-1")
+	assert.EqualError(t, a.ErrorAt(2), "100% invalid")
+}
+
+func TestAssertion_AddError(t *testing.T) {
+	a := New()
+	err := errors.New("custom")
+
+	a.AddError(nil)
+	a.AddError(err)
+
+	assert.Equal(t, 1, a.CountErrors())
+	assert.Same(t, err, a.ErrorAt(0))
+}
+
+func TestAssertion_Check(t *testing.T) {
+	a := New()
+
+	assert.True(t, a.Check(true))
+	assert.False(t, a.Check(false))
+	assert.False(t, a.Check(false, "custom error"))
+	assert.False(t, a.Check(false, "%s error", "custom"))
+
+	assert.Equal(t, 3, a.CountErrors())
+	assert.EqualError(t, a.ErrorAt(0), "check failed")
+	assert.EqualError(t, a.ErrorAt(1), "custom error")
+	assert.EqualError(t, a.ErrorAt(2), "custom error")
+}
+
+func TestAssertion_Merge(t *testing.T) {
+	address := New()
+	address.ThatString("street", "").NotEmpty()
+	address.Fail("unknown city")
+
+	items := New()
+	Each(&items, []int{1, -1}, func(a *Assertion, v int) bool { return GreaterThan(a, v, 0) })
+
+	a := New()
+	assert.True(t, a.Merge(&Assertion{}))
+	assert.False(t, a.Merge(&address, "user", "address"))
+	assert.False(t, a.Merge(&items, "items"))
+	assert.False(t, a.Merge(&items))
+
+	assert.Equal(t, 4, a.CountErrors())
+	assert.EqualError(t, a.ErrorAt(0), "user.address.street: value is empty")
+	assert.EqualError(t, a.ErrorAt(1), "user.address: unknown city")
+	assert.EqualError(t, a.ErrorAt(2), "items[1]: -1 is not greater than 0")
+	assert.EqualError(t, a.ErrorAt(3), "[1]: -1 is not greater than 0")
+}
+
 func assertAllReturnsTrue(t *testing.T, data []MethodDataOK) {
 	for _, i := range data {
 		t.Run(fmt.Sprintf("%s %v", i.method, i.okArgs), func(t *testing.T) {
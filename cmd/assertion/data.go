@@ -0,0 +1,142 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	errMsgTrailingData = `invalid character %q after top-level value`
+	errMsgColumns      = `record has %d fields, the header has %d`
+)
+
+// readFunc reads the records of a data format, calling a given function with
+// the line number and the value of each record, or the error that makes the
+// record invalid. Errors that stop the reading are returned instead
+type readFunc func(r io.Reader, fn func(line int, record interface{}, err error)) error
+
+// readers are the record readers by data format
+var readers = map[string]readFunc{
+	formatJSON:   readJSON,
+	formatNDJSON: readNDJSON,
+	formatCSV:    readCSV,
+}
+
+// readJSON reads either an array of records or a sequence of records. The line
+// of each record is the line where it starts. Data after the array or the
+// sequence is a read error
+func readJSON(r io.Reader, fn func(line int, record interface{}, err error)) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	array := bytes.HasPrefix(bytes.TrimSpace(data), []byte("["))
+	if array {
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+	}
+
+	for dec.More() {
+		line := lineAt(data, dec.InputOffset())
+
+		var record interface{}
+		if err := dec.Decode(&record); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		fn(line, record, nil)
+	}
+
+	if array {
+		if _, err := dec.Token(); err != nil {
+			return fmt.Errorf("line %d: %w", lineAt(data, dec.InputOffset()), err)
+		}
+	}
+
+	if rest := bytes.TrimSpace(data[dec.InputOffset():]); len(rest) > 0 {
+		return fmt.Errorf("line %d: "+errMsgTrailingData, lineAt(data, dec.InputOffset()), rest[0])
+	}
+
+	return nil
+}
+
+// readNDJSON reads a record per line, ignoring blank lines. Lines with data after
+// the record are a read error
+func readNDJSON(r io.Reader, fn func(line int, record interface{}, err error)) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<24)
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(text))
+		dec.UseNumber()
+
+		var record interface{}
+		if err := dec.Decode(&record); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		if rest := bytes.TrimSpace(text[dec.InputOffset():]); len(rest) > 0 {
+			return fmt.Errorf("line %d: "+errMsgTrailingData, line, rest[0])
+		}
+		fn(line, record, nil)
+	}
+
+	return scanner.Err()
+}
+
+// readCSV reads a record per row, keyed by the columns of the header row. Rows
+// with a number of fields other than the header are invalid records
+func readCSV(r io.Reader, fn func(line int, record interface{}, err error)) error {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	}
+
+	for {
+		row, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		line, _ := cr.FieldPos(0)
+		if len(row) != len(header) {
+			fn(line, nil, fmt.Errorf(errMsgColumns, len(row), len(header)))
+			continue
+		}
+
+		record := make(map[string]interface{}, len(header))
+		for i, column := range header {
+			record[column] = row[i]
+		}
+		fn(line, record, nil)
+	}
+}
+
+// lineAt returns the line of the first byte of a JSON value at or after a given
+// offset of the data, skipping whitespace and separators
+func lineAt(data []byte, offset int64) int {
+	for offset < int64(len(data)) && bytes.IndexByte([]byte(" \t\r\n,"), data[offset]) >= 0 {
+		offset++
+	}
+
+	return bytes.Count(data[:offset], []byte("\n")) + 1
+}
@@ -0,0 +1,196 @@
+// Command assertion validates the records of JSON, NDJSON and CSV data files
+// against a rules file, using the same rule strings as Assertion.Apply.
+//
+// Usage:
+//
+//	assertion -rules rules.txt [-format json|ndjson|csv] [-locale es] [file ...]
+//
+// The rules file is either a JSON object of rule strings by field, e.g.
+// {"email": "required,email"}, or a text file with a "field: rule" line per
+// field, e.g. "age: integer,between=0,150". Blank lines and lines starting with
+// # are ignored. Nested JSON fields are named by their dot separated path, e.g.
+// "address.city", while CSV fields are named by their header column verbatim.
+//
+// Data is read from the given files, or from the standard input if there are
+// none or the file is "-". The format is guessed by the file extension unless
+// given with -format. Each failure is printed as file:line: field: message. The
+// exit status is 1 if any record is invalid and 2 on usage or read errors.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/sangarbe/assertion"
+	"github.com/sangarbe/assertion/flagassert"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	exitOK      = 0
+	exitInvalid = 1
+	exitError   = 2
+)
+
+const (
+	errMsgUnknownFormat = `%s: unknown data format, use -format`
+	errMsgNotObject     = `record is not an object`
+	msgSummary          = "%d of %d records are invalid\n"
+)
+
+const (
+	formatJSON   = "json"
+	formatNDJSON = "ndjson"
+	formatCSV    = "csv"
+)
+
+// formatsByExt are the data formats by file extension
+var formatsByExt = map[string]string{
+	".json":   formatJSON,
+	".ndjson": formatNDJSON,
+	".jsonl":  formatNDJSON,
+	".csv":    formatCSV,
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run runs the command with the given arguments and standard streams, and
+// returns its exit status
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	var rulesPath, format, locale string
+
+	fs := flag.NewFlagSet("assertion", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Var(flagassert.String(&rulesPath).Required(), "rules", "rules `file`, as JSON or \"field: rule\" lines")
+	fs.Var(flagassert.String(&format).OneOf(formatJSON, formatNDJSON, formatCSV), "format", "data `format`: json, ndjson or csv (default by file extension)")
+	fs.StringVar(&locale, "locale", "", "`locale` of the error messages, e.g. es")
+	if err := flagassert.Parse(fs, args); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(stderr, err)
+		}
+		return exitError
+	}
+
+	rules, err := loadRules(rulesPath)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+
+	paths := fs.Args()
+	if len(paths) == 0 {
+		paths = []string{"-"}
+	}
+
+	v := &validator{rules: rules, out: stdout, opts: []assertion.Option{assertion.WithLocale(locale)}}
+	for _, path := range paths {
+		if err := v.validateFile(path, format, stdin); err != nil {
+			fmt.Fprintln(stderr, err)
+			return exitError
+		}
+	}
+
+	if v.invalid > 0 {
+		fmt.Fprintf(stderr, msgSummary, v.invalid, v.records)
+		return exitInvalid
+	}
+
+	return exitOK
+}
+
+// validator validates records against field rules, printing their failures
+type validator struct {
+	rules   []fieldRule
+	out     io.Writer
+	opts    []assertion.Option
+	records int
+	invalid int
+}
+
+// validateFile validates the records of a given data file, or the standard
+// input if the path is "-", in a given format or the one of its extension
+func (v *validator) validateFile(path, format string, stdin io.Reader) error {
+	name, r := path, stdin
+	if path == "-" {
+		name = "<stdin>"
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	if format == "" {
+		format = formatsByExt[strings.ToLower(filepath.Ext(path))]
+	}
+
+	read, ok := readers[format]
+	if !ok {
+		return fmt.Errorf(errMsgUnknownFormat, name)
+	}
+
+	nested := format != formatCSV
+	err := read(r, func(line int, record interface{}, err error) {
+		v.validate(name, line, record, nested, err)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	return nil
+}
+
+// validate validates a record at a given line of a named file, whose fields are
+// nested or not, or records the error that makes it invalid
+func (v *validator) validate(name string, line int, record interface{}, nested bool, err error) {
+	v.records++
+
+	a := assertion.New(v.opts...)
+	_, ok := record.(map[string]interface{})
+	switch {
+	case err != nil:
+		a.Fail(err.Error())
+	case !ok:
+		a.Fail(errMsgNotObject)
+	default:
+		for _, fr := range v.rules {
+			c := assertion.NewChain(&a, fr.field)
+			c.Check(func(a *assertion.Assertion) bool { return a.Apply(fr.rule, lookup(record, fr.field, nested)) })
+		}
+	}
+
+	if !a.HasErrors() {
+		return
+	}
+
+	v.invalid++
+	for i := 0; i < a.CountErrors(); i++ {
+		fmt.Fprintf(v.out, "%s:%d: %v\n", name, line, a.ErrorAt(i))
+	}
+}
+
+// lookup returns the value of a given field of a record, nil if it is missing.
+// Fields of nested records are dot separated paths
+func lookup(record interface{}, field string, nested bool) interface{} {
+	path := []string{field}
+	if nested {
+		path = strings.Split(field, ".")
+	}
+
+	for _, key := range path {
+		m, ok := record.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		record = m[key]
+	}
+
+	return record
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const lineRules = `
+# user rules
+email: required,email
+age: integer,between=0,150
+address.city: omitempty,lettersascii
+`
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func runCommand(stdin string, args ...string) (int, string, string) {
+	stdout, stderr := new(bytes.Buffer), new(bytes.Buffer)
+	status := run(args, strings.NewReader(stdin), stdout, stderr)
+	return status, stdout.String(), stderr.String()
+}
+
+func TestRun_CSV(t *testing.T) {
+	rules := writeFile(t, "rules.txt", lineRules)
+	data := writeFile(t, "users.csv", "email,age\nfoo@example.com,30\nfoo,200\n,abc\n")
+
+	status, stdout, stderr := runCommand("", "-rules", rules, data)
+	assert.Equal(t, exitInvalid, status)
+	assert.Equal(t, data+":3: email: foo is not a valid email\n"+
+		data+":3: age: 200 is not between 0 and 150\n"+
+		data+":4: email: value is empty\n"+
+		data+":4: age: abc is not a valid integer\n", stdout)
+	assert.Equal(t, "2 of 3 records are invalid\n", stderr)
+}
+
+func TestRun_CSV_Columns(t *testing.T) {
+	rules := writeFile(t, "rules.txt", lineRules)
+	data := writeFile(t, "users.csv", "email,age,address.city\nfoo@example.com,30,Paris\nfoo@example.com,30\nfoo@example.com,30,Paris 2\n")
+
+	status, stdout, stderr := runCommand("", "-rules", rules, data)
+	assert.Equal(t, exitInvalid, status)
+	assert.Equal(t, data+":3: record has 2 fields, the header has 3\n"+
+		data+":4: address.city: Paris 2 is not only ascii letters\n", stdout)
+	assert.Equal(t, "2 of 3 records are invalid\n", stderr)
+}
+
+func TestRun_JSON(t *testing.T) {
+	rules := writeFile(t, "rules.json", `{"email": "required,email", "address.city": "omitempty,lettersascii"}`)
+	data := writeFile(t, "users.json", `[
+  {"email": "foo@example.com", "address": {"city": "Paris"}},
+  {
+    "email": "bar",
+    "address": {"city": "Paris 2"}
+  }
+]`)
+
+	status, stdout, _ := runCommand("", "-rules", rules, data)
+	assert.Equal(t, exitInvalid, status)
+	assert.Equal(t, data+":3: email: bar is not a valid email\n"+
+		data+":3: address.city: Paris 2 is not only ascii letters\n", stdout)
+}
+
+func TestRun_NDJSON_Stdin(t *testing.T) {
+	rules := writeFile(t, "rules.txt", lineRules)
+	stdin := `{"email": "foo@example.com", "age": 30}
+
+{"email": "foo@example.com", "age": -1}
+[1, 2]
+`
+
+	status, stdout, _ := runCommand(stdin, "-rules", rules, "-format", "ndjson", "-locale", "es")
+	assert.Equal(t, exitInvalid, status)
+	assert.Equal(t, "<stdin>:3: age: -1 no está entre 0 y 150\n"+
+		"<stdin>:4: record is not an object\n", stdout)
+}
+
+func TestRun_Valid(t *testing.T) {
+	rules := writeFile(t, "rules.txt", lineRules)
+	data := writeFile(t, "users.jsonl", `{"email": "foo@example.com", "age": "30"}`)
+
+	status, stdout, stderr := runCommand("", "-rules", rules, data)
+	assert.Equal(t, exitOK, status)
+	assert.Empty(t, stdout)
+	assert.Empty(t, stderr)
+}
+
+func TestRun_Errors(t *testing.T) {
+	rules := writeFile(t, "rules.txt", lineRules)
+	badRules := writeFile(t, "bad.txt", "email: emial\n")
+	data := writeFile(t, "users.txt", "")
+	badJSON := writeFile(t, "users.json", `[{"email": }]`)
+	badNDJSON := writeFile(t, "users.ndjson", "{\"age\": 1}\n{\"age\": 1} xyz\n")
+	trailingJSON := writeFile(t, "trailing.json", "[{\"email\": \"a@b.co\"}]\n garbage")
+	truncatedJSON := writeFile(t, "truncated.json", `[{"email": "a@b.co"}`)
+	sequenceJSON := writeFile(t, "sequence.json", `{"email": "a@b.co"} ]`)
+
+	tests := []struct {
+		args   []string
+		stderr string
+	}{
+		{[]string{data}, "invalid flags: -rules: value is empty\n"},
+		{[]string{"-rules", rules, "-format", "xml", data}, "invalid flags: -format: xml is not one of [json ndjson csv]\n"},
+		{[]string{"-rules", badRules, data}, badRules + `:1: rule "emial" at offset 0: unknown rule emial` + "\n"},
+		{[]string{"-rules", rules, data}, data + ": unknown data format, use -format\n"},
+		{[]string{"-rules", rules, badJSON}, badJSON + ": line 1: invalid character '}' looking for beginning of value\n"},
+		{[]string{"-rules", rules, badNDJSON}, badNDJSON + ": line 2: invalid character 'x' after top-level value\n"},
+		{[]string{"-rules", rules, trailingJSON}, trailingJSON + ": line 2: invalid character 'g' after top-level value\n"},
+		{[]string{"-rules", rules, truncatedJSON}, truncatedJSON + ": line 1: unexpected end of JSON input\n"},
+		{[]string{"-rules", rules, sequenceJSON}, sequenceJSON + ": line 1: invalid character ']' after top-level value\n"},
+	}
+
+	for _, tt := range tests {
+		status, _, stderr := runCommand("", tt.args...)
+		assert.Equal(t, exitError, status, tt.args)
+		assert.Equal(t, tt.stderr, stderr, tt.args)
+	}
+}
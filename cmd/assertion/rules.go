@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/sangarbe/assertion"
+	"os"
+	"strings"
+)
+
+const (
+	errMsgRulesJSON  = `%s: rules must be a JSON object of rule strings: %w`
+	errMsgRulesLine  = `%s:%d: expected "field: rule"`
+	errMsgRulesField = `%s:%d: %w`
+)
+
+// fieldRule is the compiled rule of a field
+type fieldRule struct {
+	field string
+	rule  *assertion.CompiledRule
+}
+
+// loadRules returns the field rules of a given rules file, in the order they
+// are declared. Files starting with { are read as JSON, any other as lines
+func loadRules(path string) ([]fieldRule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		return parseJSONRules(path, data)
+	}
+
+	return parseLineRules(path, data)
+}
+
+// parseJSONRules parses a JSON object of rule strings by field, keeping the
+// order of the fields
+func parseJSONRules(path string, data []byte) ([]fieldRule, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if _, err := dec.Token(); err != nil {
+		return nil, fmt.Errorf(errMsgRulesJSON, path, err)
+	}
+
+	rules := make([]fieldRule, 0)
+	for dec.More() {
+		var field, source string
+		tok, err := dec.Token()
+		if err == nil {
+			field = tok.(string)
+			err = dec.Decode(&source)
+		}
+		if err != nil {
+			return nil, fmt.Errorf(errMsgRulesJSON, path, err)
+		}
+
+		rule, err := assertion.Compile(source)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", path, field, err)
+		}
+		rules = append(rules, fieldRule{field: field, rule: rule})
+	}
+
+	return rules, nil
+}
+
+// parseLineRules parses "field: rule" lines, ignoring blank lines and comments
+func parseLineRules(path string, data []byte) ([]fieldRule, error) {
+	rules := make([]fieldRule, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		field, source, ok := strings.Cut(text, ":")
+		field = strings.TrimSpace(field)
+		if !ok || field == "" {
+			return nil, fmt.Errorf(errMsgRulesLine, path, line)
+		}
+
+		rule, err := assertion.Compile(strings.TrimSpace(source))
+		if err != nil {
+			return nil, fmt.Errorf(errMsgRulesField, path, line, err)
+		}
+		rules = append(rules, fieldRule{field: field, rule: rule})
+	}
+
+	return rules, scanner.Err()
+}
@@ -0,0 +1,132 @@
+package assertion
+
+import (
+	"strings"
+)
+
+// CheckFunc is an assertion over an Assertion, used to compose assertions with
+// All, Any and None, e.g. func(a *Assertion) bool { return a.Ipv4(host) }
+type CheckFunc func(a *Assertion) bool
+
+// errorList is a list of errors that is itself an error, with the messages of
+// every error joined by semicolons
+type errorList []error
+
+// Error returns the messages of every error joined in a single message
+func (l errorList) Error() string {
+	msgs := make([]string, len(l))
+	for i, err := range l {
+		msgs[i] = err.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the errors of the list
+func (l errorList) Unwrap() []error {
+	return l
+}
+
+// localize returns the messages of every error in a given locale joined in a
+// single message
+func (l errorList) localize(locale string) string {
+	msgs := make([]string, len(l))
+	for i, err := range l {
+		if e, ok := err.(*Error); ok {
+			msgs[i] = e.Localize(locale)
+			continue
+		}
+		msgs[i] = err.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// run runs a given check on a scratch Assertion, returning its result and errors
+func run(check CheckFunc) (bool, []error) {
+	scratch := New()
+	ok := check(&scratch)
+
+	return ok, scratch.errors
+}
+
+// All returns true if every given check returns true. All the checks are run
+// and all their errors are recorded
+func (a *Assertion) All(checks ...CheckFunc) bool {
+	return a.eval(func() bool {
+		ok := true
+		for _, check := range checks {
+			if !check(a) {
+				ok = false
+			}
+		}
+
+		return ok
+	})
+}
+
+// Any returns true if some of the given checks returns true, e.g. a host being
+// either an ipv4 or a domain. If every check fails, a single error combining the
+// error of each alternative is recorded. The errors of the alternatives can be
+// retrieved with errors.As or through the Unwrap method of the error, e.g.
+// a.Any([]CheckFunc{isIpv4, isDomain}, "invalid host")
+func (a *Assertion) Any(checks []CheckFunc, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		errs := make([]error, 0)
+		for _, check := range checks {
+			ok, checkErrs := run(check)
+			if ok {
+				return true
+			}
+
+			errs = append(errs, checkErrs...)
+		}
+
+		err := NewError("any", nil, map[string]interface{}{"Errors": errorList(errs)}, msgArgs...)
+		err.errs = errs
+		a.addError(err)
+		return false
+	})
+}
+
+// None returns true if every given check returns false. Otherwise an error
+// listing the satisfied alternatives, by their 1-based position, is recorded
+func (a *Assertion) None(checks []CheckFunc, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		satisfied := make([]int, 0)
+		for i, check := range checks {
+			if ok, _ := run(check); ok {
+				satisfied = append(satisfied, i+1)
+			}
+		}
+
+		if len(satisfied) > 0 {
+			a.fail("none", nil, map[string]interface{}{"Satisfied": satisfied}, msgArgs...)
+			return false
+		}
+
+		return true
+	})
+}
+
+// When runs the assertions of a given function only if a given condition is
+// true, e.g. a zip code format that only applies to a certain country. It returns
+// true if the condition is false or the function records no errors
+func (a *Assertion) When(cond bool, fn func(a *Assertion)) bool {
+	if !cond {
+		return true
+	}
+
+	return a.eval(func() bool {
+		scratch := New()
+		fn(&scratch)
+
+		return a.Merge(&scratch)
+	})
+}
+
+// Unless runs the assertions of a given function only if a given condition is
+// false. It returns true if the condition is true or the function records no errors
+func (a *Assertion) Unless(cond bool, fn func(a *Assertion)) bool {
+	return a.When(!cond, fn)
+}
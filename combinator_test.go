@@ -0,0 +1,91 @@
+package assertion
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAssertion_All(t *testing.T) {
+	a := New()
+
+	assert.True(t, a.All(
+		func(a *Assertion) bool { return a.Digits("123") },
+		func(a *Assertion) bool { return a.Integer("123") },
+	))
+	assert.False(t, a.All(
+		func(a *Assertion) bool { return a.Digits("12a") },
+		func(a *Assertion) bool { return a.Integer("123") },
+		func(a *Assertion) bool { return a.Integer("12a") },
+	))
+
+	assert.Equal(t, 2, a.CountErrors())
+	assert.EqualError(t, a.ErrorAt(0), "12a is not only digits")
+	assert.EqualError(t, a.ErrorAt(1), "12a is not a valid integer")
+}
+
+func TestAssertion_Any(t *testing.T) {
+	a := New()
+	ipv4OrEmail := func(value string) []CheckFunc {
+		return []CheckFunc{
+			func(a *Assertion) bool { return a.Ipv4(value) },
+			func(a *Assertion) bool { return a.Email(value) },
+		}
+	}
+
+	assert.True(t, a.Any(ipv4OrEmail("127.0.0.1")))
+	assert.True(t, a.Any(ipv4OrEmail("test@mail.com")))
+	assert.False(t, a.HasErrors())
+
+	assert.False(t, a.Any(ipv4OrEmail("plainaddress")))
+	assert.Equal(t, 1, a.CountErrors())
+	assert.EqualError(t, a.ErrorAt(0), "no alternative is satisfied: plainaddress is not a valid ipv4; plainaddress is not a valid email")
+
+	var ae *Error
+	assert.True(t, errors.As(a.ErrorAt(0), &ae))
+	assert.Equal(t, "any", ae.Rule)
+	assert.Len(t, ae.Unwrap(), 2)
+
+	assert.False(t, a.Any(ipv4OrEmail("plainaddress"), "invalid host %s", "plainaddress"))
+	assert.EqualError(t, a.ErrorAt(1), "invalid host plainaddress")
+}
+
+func TestAssertion_None(t *testing.T) {
+	a := New()
+	checks := func(value string) []CheckFunc {
+		return []CheckFunc{
+			func(a *Assertion) bool { return a.Contains(value, "admin") },
+			func(a *Assertion) bool { return a.Contains(value, "root") },
+			func(a *Assertion) bool { return a.Digits(value) },
+		}
+	}
+
+	assert.True(t, a.None(checks("john")))
+	assert.False(t, a.HasErrors())
+
+	assert.False(t, a.None(checks("rootadmin")))
+	assert.Equal(t, 1, a.CountErrors())
+	assert.EqualError(t, a.ErrorAt(0), "alternatives [1 2] are satisfied")
+
+	assert.False(t, a.None(checks("rootadmin"), "reserved username"))
+	assert.EqualError(t, a.ErrorAt(1), "reserved username")
+}
+
+func TestAssertion_WhenUnless(t *testing.T) {
+	a := New()
+	zip := func(code string) func(a *Assertion) {
+		return func(a *Assertion) {
+			a.Matches(code, `^\d{5}$`)
+		}
+	}
+
+	assert.True(t, a.When(false, zip("ABC")))
+	assert.True(t, a.When(true, zip("12345")))
+	assert.False(t, a.When(true, zip("ABC")))
+	assert.True(t, a.Unless(true, zip("ABC")))
+	assert.False(t, a.Unless(false, zip("1234")))
+
+	assert.Equal(t, 2, a.CountErrors())
+	assert.EqualError(t, a.ErrorAt(0), `ABC does not match ^\d{5}$`)
+	assert.EqualError(t, a.ErrorAt(1), `1234 does not match ^\d{5}$`)
+}
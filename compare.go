@@ -1,8 +1,9 @@
 package assertion
 
 import (
-	"fmt"
+	"errors"
 	"reflect"
+	"unicode/utf8"
 )
 
 const (
@@ -14,13 +15,13 @@ const (
 	cmpOpGreaterEqual
 )
 
-var errMsgByOp = map[int]string{
-	cmpOpEqual:        errMsgNotEqual,
-	cmpOpNotEqual:     errMsgNotDifferent,
-	cmpOpGreater:      errMsgNotGreater,
-	cmpOpLowerEqual:   errMsgNotLowerEqual,
-	cmpOpLower:        errMsgNotLower,
-	cmpOpGreaterEqual: errMsgNotGreaterEqual,
+var ruleByOp = map[int]string{
+	cmpOpEqual:        "equal",
+	cmpOpNotEqual:     "different",
+	cmpOpGreater:      "gt",
+	cmpOpLowerEqual:   "lte",
+	cmpOpLower:        "lt",
+	cmpOpGreaterEqual: "gte",
 }
 
 // compare returns true if a given value and other operand satisfy the compare
@@ -29,14 +30,14 @@ var errMsgByOp = map[int]string{
 func compare(op int, value, other interface{}, msgArgs ...interface{}) (bool, error) {
 	rv, ro := reflect.ValueOf(value), reflect.ValueOf(other)
 	if rv.Kind() != ro.Kind() {
-		return false, buildError(fmt.Sprintf(errMsgNotSameType, value, other), msgArgs...)
+		return false, NewError("sametype", value, map[string]interface{}{"Other": other}, msgArgs...)
 	}
 
 	switch op {
 	case cmpOpNotEqual, cmpOpGreaterEqual, cmpOpLowerEqual:
 		ok, err := compare(op-1, value, other, msgArgs...)
 		if ok {
-			err = buildError(fmt.Sprintf(errMsgByOp[op], value, other), msgArgs...)
+			err = NewError(ruleByOp[op], value, map[string]interface{}{"Other": other}, msgArgs...)
 		}
 		return !ok, err
 	}
@@ -69,146 +70,234 @@ func compare(op int, value, other interface{}, msgArgs ...interface{}) (bool, er
 		}
 	}
 
-	return false, buildError(fmt.Sprintf(errMsgByOp[op], value, other), msgArgs...)
+	return false, NewError(ruleByOp[op], value, map[string]interface{}{"Other": other}, msgArgs...)
 }
 
 // validateArgsLength panics if args length is lower than minLength
 func validateArgsLength(minLength int, args ...interface{}) {
 	if len(args) < minLength {
-		panic(buildError(errMsgMissingArgs))
+		panic(errors.New(errMsgMissingArgs))
 	}
 }
 
 // Nil returns true if a given bool value is equal to other bool value
 func (a *Assertion) Nil(args ...interface{}) bool {
-	validateArgsLength(1, args...)
+	return a.eval(func() bool {
+		validateArgsLength(1, args...)
 
-	if args[0] == nil {
-		return true
-	}
-
-	v := reflect.ValueOf(args[0])
-	switch v.Kind() {
-	case reflect.Chan, reflect.Func,
-		reflect.Interface, reflect.Map,
-		reflect.Ptr, reflect.Slice:
-		if v.IsNil() {
+		if args[0] == nil {
 			return true
 		}
-	}
 
-	a.addError(buildError(fmt.Sprintf(errMsgNot, args[0], nil), args[1:]...))
-	return false
+		v := reflect.ValueOf(args[0])
+		switch v.Kind() {
+		case reflect.Chan, reflect.Func,
+			reflect.Interface, reflect.Map,
+			reflect.Ptr, reflect.Slice:
+			if v.IsNil() {
+				return true
+			}
+		}
+
+		a.fail("nil", args[0], nil, args[1:]...)
+		return false
+	})
 }
 
 // Equal returns true if a given value is equal to other value
 func (a *Assertion) Equal(args ...interface{}) bool {
-	validateArgsLength(2, args...)
+	return a.eval(func() bool {
+		validateArgsLength(2, args...)
 
-	ok, err := compare(cmpOpEqual, args[0], args[1], args[2:]...)
-	if !ok {
-		a.addError(err)
-	}
+		ok, err := compare(cmpOpEqual, args[0], args[1], args[2:]...)
+		if !ok {
+			a.addError(err)
+		}
+
+		return ok
+	})
+}
 
-	return ok
+// EqualString returns true if a given string is equal to other string when both
+// are compared with the given comparison mode
+func (a *Assertion) EqualString(value, other string, mode Comparison, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		if normalize(value, mode, a.locale) != normalize(other, mode, a.locale) {
+			a.fail("equal", value, map[string]interface{}{"Other": other}, msgArgs...)
+			return false
+		}
+
+		return true
+	})
 }
 
 // True returns true if a given bool value is true
 func (a *Assertion) True(value bool, msgArgs ...interface{}) bool {
-	ok, err := compare(cmpOpEqual, value, true, msgArgs...)
-	if !ok {
-		a.addError(err)
-	}
+	return a.eval(func() bool {
+		ok, err := compare(cmpOpEqual, value, true, msgArgs...)
+		if !ok {
+			a.addError(err)
+		}
 
-	return ok
+		return ok
+	})
 }
 
 // False returns true if a given bool value is false
 func (a *Assertion) False(value bool, msgArgs ...interface{}) bool {
-	ok, err := compare(cmpOpEqual, value, false, msgArgs...)
-	if !ok {
-		a.addError(err)
-	}
+	return a.eval(func() bool {
+		ok, err := compare(cmpOpEqual, value, false, msgArgs...)
+		if !ok {
+			a.addError(err)
+		}
 
-	return ok
+		return ok
+	})
 }
 
 // GreaterThan returns true if a given int64 value is greater than other int64 value
 func (a *Assertion) GreaterThan(args ...interface{}) bool {
-	validateArgsLength(2, args...)
+	return a.eval(func() bool {
+		validateArgsLength(2, args...)
 
-	ok, err := compare(cmpOpGreater, args[0], args[1], args[2:]...)
-	if !ok {
-		a.addError(err)
-	}
+		ok, err := compare(cmpOpGreater, args[0], args[1], args[2:]...)
+		if !ok {
+			a.addError(err)
+		}
 
-	return ok
+		return ok
+	})
 }
 
 // GreaterThanOrEqual returns true if a given value is greater than or equal to other value
 func (a *Assertion) GreaterThanOrEqual(args ...interface{}) bool {
-	validateArgsLength(2, args...)
+	return a.eval(func() bool {
+		validateArgsLength(2, args...)
 
-	ok, err := compare(cmpOpGreaterEqual, args[0], args[1], args[2:]...)
-	if !ok {
-		a.addError(err)
-	}
+		ok, err := compare(cmpOpGreaterEqual, args[0], args[1], args[2:]...)
+		if !ok {
+			a.addError(err)
+		}
 
-	return ok
+		return ok
+	})
 }
 
 // LowerThan returns true if a given value is lower than other value
 func (a *Assertion) LowerThan(args ...interface{}) bool {
-	validateArgsLength(2, args...)
+	return a.eval(func() bool {
+		validateArgsLength(2, args...)
 
-	ok, err := compare(cmpOpLower, args[0], args[1], args[2:]...)
-	if !ok {
-		a.addError(err)
-	}
+		ok, err := compare(cmpOpLower, args[0], args[1], args[2:]...)
+		if !ok {
+			a.addError(err)
+		}
 
-	return ok
+		return ok
+	})
 }
 
 // LowerThanOrEqual returns true if a given value is lower than or equal to other value
 func (a *Assertion) LowerThanOrEqual(args ...interface{}) bool {
-	validateArgsLength(2, args...)
+	return a.eval(func() bool {
+		validateArgsLength(2, args...)
 
-	ok, err := compare(cmpOpLowerEqual, args[0], args[1], args[2:]...)
-	if !ok {
-		a.addError(err)
-	}
+		ok, err := compare(cmpOpLowerEqual, args[0], args[1], args[2:]...)
+		if !ok {
+			a.addError(err)
+		}
 
-	return ok
+		return ok
+	})
 }
 
 // Between returns true if a given value is between a lower and upper
 // limit values (including both)
 func (a *Assertion) Between(args ...interface{}) bool {
-	validateArgsLength(3, args...)
-
-	for op, v := range map[int]interface{}{cmpOpGreaterEqual: args[1], cmpOpLowerEqual: args[2]} {
-		ok, _ := compare(op, args[0], v, args[3:]...)
-		if !ok {
-			a.addError(buildError(fmt.Sprintf(errMsgNotBetween, args[0], args[1], args[2]), args[3:]...))
-			return false
+	return a.eval(func() bool {
+		validateArgsLength(3, args...)
+
+		for op, v := range map[int]interface{}{cmpOpGreaterEqual: args[1], cmpOpLowerEqual: args[2]} {
+			ok, _ := compare(op, args[0], v, args[3:]...)
+			if !ok {
+				a.fail("between", args[0], map[string]interface{}{"Min": args[1], "Max": args[2]}, args[3:]...)
+				return false
+			}
 		}
-	}
 
-	return true
+		return true
+	})
 }
 
 // BetweenExclude returns true if a given value is between a lower and upper
 // limit values (excluding both)
 func (a *Assertion) BetweenExclude(args ...interface{}) bool {
-	validateArgsLength(3, args...)
+	return a.eval(func() bool {
+		validateArgsLength(3, args...)
+
+		for op, v := range map[int]interface{}{cmpOpGreater: args[1], cmpOpLower: args[2]} {
+			ok, _ := compare(op, args[0], v, args[3:]...)
+			if !ok {
+				a.fail("betweenexclude", args[0], map[string]interface{}{"Min": args[1], "Max": args[2]}, args[3:]...)
+				return false
+			}
+		}
 
-	for op, v := range map[int]interface{}{cmpOpGreater: args[1], cmpOpLower: args[2]} {
-		ok, _ := compare(op, args[0], v, args[3:]...)
+		return true
+	})
+}
+
+// MinLength returns true if the length of a given value is greater than or equal
+// to a minimum length. Strings are measured in runes, and slices, arrays and maps
+// in elements
+func (a *Assertion) MinLength(value interface{}, min int, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		n, ok := length(value)
 		if !ok {
-			a.addError(buildError(fmt.Sprintf(errMsgNotBetweenExclude, args[0], args[1], args[2]), args[3:]...))
+			a.fail("length", value, nil, msgArgs...)
+			return false
+		}
+
+		if n < min {
+			a.fail("minlen", value, map[string]interface{}{"Min": min}, msgArgs...)
 			return false
 		}
+
+		return true
+	})
+}
+
+// MaxLength returns true if the length of a given value is lower than or equal
+// to a maximum length. Lengths are measured as in MinLength
+func (a *Assertion) MaxLength(value interface{}, max int, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		n, ok := length(value)
+		if !ok {
+			a.fail("length", value, nil, msgArgs...)
+			return false
+		}
+
+		if n > max {
+			a.fail("maxlen", value, map[string]interface{}{"Max": max}, msgArgs...)
+			return false
+		}
+
+		return true
+	})
+}
+
+// length returns the number of runes of a given string, or the number of
+// elements of a given slice, array or map
+func length(value interface{}) (int, bool) {
+	if s, ok := value.(string); ok {
+		return utf8.RuneCountInString(s), true
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return v.Len(), true
 	}
 
-	return true
+	return 0, false
 }
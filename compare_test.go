@@ -97,6 +97,29 @@ func TestAssertion_Equal_ReturnsFalse(t *testing.T) {
 	assertAllReturnsFalse(t, data)
 }
 
+func TestAssertion_EqualString_ReturnsTrue(t *testing.T) {
+	data := []MethodDataOK{
+		{"EqualString", []interface{}{"a", "a", CompareExact}},
+		{"EqualString", []interface{}{"STRASSE", "straße", CompareFold}},
+		{"EqualString", []interface{}{"Jos\u00e9", "Jose\u0301", CompareNFC}},
+		{"EqualString", []interface{}{"JOSE\u0301", "jos\u00e9", CompareFold | CompareNFC}},
+		{"EqualString", []interface{}{"\u2460", "1", CompareNFKC}},
+	}
+
+	assertAllReturnsTrue(t, data)
+}
+
+func TestAssertion_EqualString_ReturnsFalse(t *testing.T) {
+	data := []MethodDataKO{
+		{"EqualString", []interface{}{"a", "A", CompareExact}, "a is not equal A"},
+		{"EqualString", []interface{}{"Jos\u00e9", "Jose\u0301", CompareExact}, "Jos\u00e9 is not equal Jose\u0301"},
+		{"EqualString", []interface{}{"JOSE\u0301", "jos\u00e9", CompareFold}, "JOSE\u0301 is not equal jos\u00e9"},
+		{"EqualString", []interface{}{"\u2460", "1", CompareNFC}, "\u2460 is not equal 1"},
+	}
+
+	assertAllReturnsFalse(t, data)
+}
+
 func TestAssertion_True_ReturnsTrue(t *testing.T) {
 	data := []MethodDataOK{
 		{"True", []interface{}{true}},
@@ -510,3 +533,43 @@ func TestAssertion_VariadicMethods_PanicIfInvalidArgumentCount(t *testing.T) {
 	})
 
 }
+
+func TestAssertion_MinLength_ReturnsTrue(t *testing.T) {
+	data := []MethodDataOK{
+		{"MinLength", []interface{}{"abc", 3}},
+		{"MinLength", []interface{}{"ñañ", 3}},
+		{"MinLength", []interface{}{[]int{1, 2}, 1}},
+		{"MinLength", []interface{}{map[string]int{"a": 1}, 0}},
+	}
+
+	assertAllReturnsTrue(t, data)
+}
+
+func TestAssertion_MinLength_ReturnsFalse(t *testing.T) {
+	data := []MethodDataKO{
+		{"MinLength", []interface{}{"ab", 3}, "ab is shorter than 3"},
+		{"MinLength", []interface{}{[]int{}, 1}, "[] is shorter than 1"},
+		{"MinLength", []interface{}{12, 1}, "12 has no length"},
+	}
+
+	assertAllReturnsFalse(t, data)
+}
+
+func TestAssertion_MaxLength_ReturnsTrue(t *testing.T) {
+	data := []MethodDataOK{
+		{"MaxLength", []interface{}{"ñañ", 3}},
+		{"MaxLength", []interface{}{[2]int{1, 2}, 2}},
+	}
+
+	assertAllReturnsTrue(t, data)
+}
+
+func TestAssertion_MaxLength_ReturnsFalse(t *testing.T) {
+	data := []MethodDataKO{
+		{"MaxLength", []interface{}{"abcd", 3}, "abcd is longer than 3"},
+		{"MaxLength", []interface{}{map[string]int{"a": 1, "b": 2}, 1}, "map[a:1 b:2] is longer than 1"},
+		{"MaxLength", []interface{}{true, 1}, "true has no length"},
+	}
+
+	assertAllReturnsFalse(t, data)
+}
@@ -0,0 +1,75 @@
+package assertion
+
+import (
+	"context"
+)
+
+// Go runs a given function in a new goroutine over its own Assertion, so
+// independent checks can be executed in parallel. The errors of every function
+// are merged into the Assertion by Wait, in submission order
+func (a *Assertion) Go(fn func(a *Assertion)) {
+	if a.done() {
+		return
+	}
+
+	task := New()
+	state := a.state()
+
+	a.lock()
+	state.pending = append(state.pending, &task)
+	state.tasks.Add(1)
+	a.unlock()
+
+	go func() {
+		defer state.tasks.Done()
+		fn(&task)
+	}()
+}
+
+// Wait waits for every function started with Go to finish, and adds their errors
+// to the Assertion in the order the functions were submitted. It returns true if
+// none of them failed
+func (a *Assertion) Wait() bool {
+	state := a.state()
+	state.tasks.Wait()
+
+	a.lock()
+	pending := state.pending
+	state.pending = nil
+	a.unlock()
+
+	ok := true
+	for _, task := range pending {
+		for _, err := range task.errors {
+			ok = false
+			a.addError(err)
+		}
+	}
+
+	return ok
+}
+
+// Async runs a given check in a new goroutine, as Go does, for assertions that
+// depend on slow resources like a database or the network. The check receives
+// the given context, and a check not finished when the context is done fails
+// with the context error. A non nil error returned by the check is added to
+// the Assertion by Wait, prefixed by name
+func (a *Assertion) Async(ctx context.Context, name string, check func(ctx context.Context) error) {
+	a.Go(func(a *Assertion) {
+		result := make(chan error, 1)
+		go func() {
+			result <- check(ctx)
+		}()
+
+		var err error
+		select {
+		case err = <-result:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+
+		if err != nil {
+			a.addError(newFieldError(name, err))
+		}
+	})
+}
@@ -0,0 +1,96 @@
+package assertion
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAssertion_Concurrent(t *testing.T) {
+	a := New()
+	wg := sync.WaitGroup{}
+
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			a.GreaterThan(i, 25)
+			a.HasErrors()
+			a.ErrorAt(-1)
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 26, a.CountErrors())
+}
+
+func TestAssertion_Concurrent_WithMaxErrors(t *testing.T) {
+	a := New(WithMaxErrors(5))
+	wg := sync.WaitGroup{}
+
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Email("plainaddress")
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 5, a.CountErrors())
+}
+
+func TestAssertion_GoWait(t *testing.T) {
+	a := New()
+
+	for i := 0; i < 10; i++ {
+		i := i
+		a.Go(func(a *Assertion) {
+			time.Sleep(time.Duration(10-i) * time.Millisecond)
+			a.LowerThan(i, 5)
+			a.That(fmt.Sprint("item", i), i).Equal(i)
+		})
+	}
+
+	assert.False(t, a.Wait())
+	assert.Equal(t, 5, a.CountErrors())
+	for i := 0; i < 5; i++ {
+		assert.EqualError(t, a.ErrorAt(i), fmt.Sprintf("%d is not lower than 5", i+5))
+	}
+
+	a.Go(func(a *Assertion) { a.Email("test@mail.com") })
+	assert.True(t, a.Wait())
+	assert.Equal(t, 5, a.CountErrors())
+}
+
+func TestAssertion_GoWait_FailFast(t *testing.T) {
+	a := New(WithFailFast())
+
+	a.Go(func(a *Assertion) { a.Email("first") })
+	a.Go(func(a *Assertion) { a.Email("second") })
+	assert.False(t, a.Wait())
+
+	called := false
+	a.Go(func(a *Assertion) { called = true })
+	a.Wait()
+
+	assert.False(t, called)
+	assert.Equal(t, 1, a.CountErrors())
+	assert.EqualError(t, a.ErrorAt(0), "first is not a valid email")
+}
+
+func TestAssertion_GoWait_ZeroValue(t *testing.T) {
+	var a Assertion
+
+	a.Go(func(a *Assertion) { a.Email("plainaddress") })
+	a.Go(func(a *Assertion) { a.Email("test@mail.com") })
+
+	assert.False(t, a.Wait())
+	assert.Equal(t, 1, a.CountErrors())
+	assert.EqualError(t, a.ErrorAt(0), "plainaddress is not a valid email")
+
+	var b Assertion
+	assert.True(t, b.Wait())
+}
@@ -0,0 +1,39 @@
+package assertion
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+)
+
+// MXResolver looks up the DNS MX records of a domain. *net.Resolver satisfies it,
+// and tests may provide their own implementation
+type MXResolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+}
+
+// EmailMX returns a check, to be run with Assertion.Async, that fails if the
+// domain of a given email has no MX records. If resolver is nil the default
+// net resolver is used
+func EmailMX(resolver MXResolver, email string) func(ctx context.Context) error {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+
+	return func(ctx context.Context) error {
+		domain := email[strings.LastIndex(email, "@")+1:]
+		records, err := resolver.LookupMX(ctx, domain)
+
+		var dnsErr *net.DNSError
+		if err != nil && !(errors.As(err, &dnsErr) && dnsErr.IsNotFound) {
+			return err
+		}
+
+		if len(records) == 0 {
+			return NewError("mx", domain, nil)
+		}
+
+		return nil
+	}
+}
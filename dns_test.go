@@ -0,0 +1,93 @@
+package assertion
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net"
+	"testing"
+	"time"
+)
+
+// fakeResolver resolves MX records from a fixed table, waiting delay before
+// answering unless the context is done first
+type fakeResolver struct {
+	records map[string][]*net.MX
+	delay   time.Duration
+}
+
+func (r *fakeResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	select {
+	case <-time.After(r.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if name == "broken.com" {
+		return nil, errors.New("server misbehaving")
+	}
+
+	if mx, ok := r.records[name]; ok {
+		return mx, nil
+	}
+
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func newFakeResolver(delay time.Duration) *fakeResolver {
+	return &fakeResolver{
+		records: map[string][]*net.MX{"mail.com": {{Host: "mx.mail.com.", Pref: 10}}, "nomx.com": {}},
+		delay:   delay,
+	}
+}
+
+func TestAssertion_Async(t *testing.T) {
+	a := New()
+	r := newFakeResolver(0)
+
+	a.Async(context.Background(), "email", EmailMX(r, "test@mail.com"))
+	a.Async(context.Background(), "email2", EmailMX(r, "test@nomx.com"))
+	a.Async(context.Background(), "email3", EmailMX(r, "test@unknown.com"))
+	a.Async(context.Background(), "email4", EmailMX(r, "test@broken.com"))
+	a.Async(context.Background(), "user", func(ctx context.Context) error {
+		return errors.New("already taken")
+	})
+
+	assert.False(t, a.Wait())
+	assert.Equal(t, 4, a.CountErrors())
+	assert.EqualError(t, a.ErrorAt(0), "email2: nomx.com has no mx records")
+	assert.EqualError(t, a.ErrorAt(1), "email3: unknown.com has no mx records")
+	assert.EqualError(t, a.ErrorAt(2), "email4: server misbehaving")
+	assert.EqualError(t, a.ErrorAt(3), "user: already taken")
+}
+
+func TestAssertion_Async_Timeout(t *testing.T) {
+	a := New()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	a.Async(ctx, "email", EmailMX(newFakeResolver(time.Second), "test@mail.com"))
+	a.Async(ctx, "slow", func(ctx context.Context) error {
+		time.Sleep(time.Second)
+		return nil
+	})
+
+	start := time.Now()
+	assert.False(t, a.Wait())
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+	assert.Equal(t, 2, a.CountErrors())
+	assert.True(t, errors.Is(a.ErrorAt(0), context.DeadlineExceeded))
+	assert.True(t, errors.Is(a.ErrorAt(1), context.DeadlineExceeded))
+	assert.EqualError(t, a.ErrorAt(1), "slow: context deadline exceeded")
+}
+
+func TestAssertion_Async_Canceled(t *testing.T) {
+	a := New()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	a.Async(ctx, "email", EmailMX(newFakeResolver(time.Second), "test@mail.com"))
+
+	assert.False(t, a.Wait())
+	assert.True(t, errors.Is(a.ErrorAt(0), context.Canceled))
+}
@@ -0,0 +1,554 @@
+package assertion
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// CompiledRule is a rule string compiled with Compile, e.g.
+// "required,email,maxlen=254". It can be applied any number of times, from
+// multiple goroutines, with Assertion.Apply
+type CompiledRule struct {
+	source string
+	steps  []ruleStep
+}
+
+// ruleStep is one of the rules of a compiled rule string
+type ruleStep struct {
+	name      string
+	omitEmpty bool
+	apply     applyFunc
+}
+
+// applyFunc asserts a value for a compiled rule
+type applyFunc func(a *Assertion, value interface{}) bool
+
+// paramKind determines how a rule parameter is parsed
+type paramKind int
+
+const (
+	paramString paramKind = iota
+	paramNumber
+	paramInt
+	paramPattern
+	paramList
+)
+
+// ruleDef defines a rule of the rule string syntax by the kinds of its
+// parameters and a function building the assertion from the parsed parameters
+type ruleDef struct {
+	params []paramKind
+	build  func(params []interface{}) applyFunc
+}
+
+// SyntaxError is the error returned by Compile for a malformed rule string, and
+// recorded by Assertion.At for a malformed path. The offset is the position in
+// the rule string or the path where the error was found
+type SyntaxError struct {
+	Source string
+	Offset int
+	Msg    string
+	path   bool
+}
+
+// Error returns the error message including the rule string or the path and
+// the offset
+func (e *SyntaxError) Error() string {
+	if e.path {
+		return fmt.Sprintf(errMsgPathSyntax, e.Source, e.Offset, e.Msg)
+	}
+
+	return fmt.Sprintf(errMsgRuleSyntax, e.Source, e.Offset, e.Msg)
+}
+
+// stringMethods are the assertion methods over strings available as rules
+// without parameters
+var stringMethods = map[string]func(a *Assertion, value string, msgArgs ...interface{}) bool{
+	"email":          (*Assertion).Email,
+	"phone":          (*Assertion).Phone,
+	"ipv4":           (*Assertion).Ipv4,
+	"url":            (*Assertion).URL,
+	"uri":            (*Assertion).URI,
+	"hostname":       (*Assertion).Hostname,
+	"ipv6":           (*Assertion).Ipv6,
+	"uuid":           (*Assertion).UUID,
+	"datetime":       (*Assertion).DateTime,
+	"date":           (*Assertion).Date,
+	"alfanum":        (*Assertion).Alfanum,
+	"alfanumascii":   (*Assertion).AlfanumASCII,
+	"digits":         (*Assertion).Digits,
+	"digitsascii":    (*Assertion).DigitsASCII,
+	"letters":        (*Assertion).Letters,
+	"lettersascii":   (*Assertion).LettersASCII,
+	"ascii":          (*Assertion).ASCII,
+	"printableascii": (*Assertion).PrintableASCII,
+	"lowercase":      (*Assertion).Lowercase,
+	"uppercase":      (*Assertion).Uppercase,
+	"nowhitespace":   (*Assertion).NoWhitespace,
+	"nocontrolchars": (*Assertion).NoControlChars,
+	"utf8":           (*Assertion).ValidUTF8,
+	"hexadecimal":    (*Assertion).Hexadecimal,
+	"slug":           (*Assertion).Slug,
+	"boolean":        (*Assertion).Boolean,
+	"truthy":         (*Assertion).Truthy,
+	"falsy":          (*Assertion).Falsy,
+	"integer":        (*Assertion).Integer,
+	"unsigned":       (*Assertion).Unsigned,
+	"float":          (*Assertion).Float,
+	"base64":         (*Assertion).Base64,
+}
+
+// needleMethods are the assertion methods over strings available as rules with
+// a string parameter
+var needleMethods = map[string]func(a *Assertion, value, needle string, msgArgs ...interface{}) bool{
+	"startswith":  (*Assertion).StartsWith,
+	"endswith":    (*Assertion).EndsWith,
+	"contains":    (*Assertion).Contains,
+	"istartswith": (*Assertion).StartsWithInsensitive,
+	"iendswith":   (*Assertion).EndsWithInsensitive,
+	"icontains":   (*Assertion).ContainsInsensitive,
+}
+
+// numberComparisons are the comparison rules with a number parameter, by name,
+// with the rule of their errors and whether a comparison result satisfies them
+var numberComparisons = map[string]struct {
+	rule string
+	ok   func(cmp int) bool
+}{
+	"gt":  {"gt", func(cmp int) bool { return cmp > 0 }},
+	"gte": {"gte", func(cmp int) bool { return cmp >= 0 }},
+	"lt":  {"lt", func(cmp int) bool { return cmp < 0 }},
+	"lte": {"lte", func(cmp int) bool { return cmp <= 0 }},
+	"min": {"gte", func(cmp int) bool { return cmp >= 0 }},
+	"max": {"lte", func(cmp int) bool { return cmp <= 0 }},
+}
+
+// ruleDefs are the rules of the rule string syntax, by name
+var ruleDefs = map[string]ruleDef{
+	"required": {build: func(params []interface{}) applyFunc {
+		return func(a *Assertion, value interface{}) bool {
+			if !isEmpty(value) {
+				return true
+			}
+
+			a.fail("required", value, nil)
+			return false
+		}
+	}},
+	"between": {params: []paramKind{paramNumber, paramNumber}, build: func(params []interface{}) applyFunc {
+		min, max := params[0].(numberParam), params[1].(numberParam)
+		return numberRule(func(a *Assertion, value interface{}, n *big.Rat) bool {
+			if n.Cmp(min.rat) >= 0 && n.Cmp(max.rat) <= 0 {
+				return true
+			}
+
+			a.fail("between", value, map[string]interface{}{"Min": min, "Max": max})
+			return false
+		})
+	}},
+	"eq": {params: []paramKind{paramString}, build: func(params []interface{}) applyFunc {
+		return stringRule(func(a *Assertion, value string) bool {
+			return a.EqualString(value, params[0].(string), CompareExact)
+		})
+	}},
+	"oneof": {params: []paramKind{paramList}, build: func(params []interface{}) applyFunc {
+		return stringRule(func(a *Assertion, value string) bool {
+			return OneOf(a, value, params[0].([]string))
+		})
+	}},
+	"minlen": {params: []paramKind{paramInt}, build: func(params []interface{}) applyFunc {
+		return func(a *Assertion, value interface{}) bool {
+			return a.MinLength(value, params[0].(int))
+		}
+	}},
+	"maxlen": {params: []paramKind{paramInt}, build: func(params []interface{}) applyFunc {
+		return func(a *Assertion, value interface{}) bool {
+			return a.MaxLength(value, params[0].(int))
+		}
+	}},
+	"matches": {params: []paramKind{paramPattern}, build: func(params []interface{}) applyFunc {
+		return stringRule(func(a *Assertion, value string) bool {
+			return a.Matches(value, params[0])
+		})
+	}},
+	"notmatches": {params: []paramKind{paramPattern}, build: func(params []interface{}) applyFunc {
+		return stringRule(func(a *Assertion, value string) bool {
+			return a.NotMatches(value, params[0])
+		})
+	}},
+}
+
+func init() {
+	for name, method := range stringMethods {
+		method := method
+		ruleDefs[name] = ruleDef{build: func(params []interface{}) applyFunc {
+			return stringRule(func(a *Assertion, value string) bool {
+				return method(a, value)
+			})
+		}}
+	}
+
+	for name, method := range needleMethods {
+		method := method
+		ruleDefs[name] = ruleDef{params: []paramKind{paramString}, build: func(params []interface{}) applyFunc {
+			return stringRule(func(a *Assertion, value string) bool {
+				return method(a, value, params[0].(string))
+			})
+		}}
+	}
+
+	for name, comparison := range numberComparisons {
+		comparison := comparison
+		ruleDefs[name] = ruleDef{params: []paramKind{paramNumber}, build: func(params []interface{}) applyFunc {
+			other := params[0].(numberParam)
+			return numberRule(func(a *Assertion, value interface{}, n *big.Rat) bool {
+				if comparison.ok(n.Cmp(other.rat)) {
+					return true
+				}
+
+				a.fail(comparison.rule, value, map[string]interface{}{"Other": other})
+				return false
+			})
+		}}
+	}
+}
+
+// Compile parses a rule string made of comma separated rules, where parameters
+// follow an equal sign, e.g. "required,email,maxlen=254" or "integer,between=0,150".
+// Commas inside parameters can be escaped as "\,". Rules registered with
+// RegisterRule can be used too, receiving their parameter as a string. The
+// "omitempty" rule accepts empty values without checking the rules following it.
+// Any other rule is negated with a leading "!", e.g. "!startswith=tmp-", failing
+// with the "not" rule when the value satisfies it.
+//
+// Errors are of type *SyntaxError, giving the position of unknown rules and
+// invalid parameters
+func Compile(source string) (*CompiledRule, error) {
+	rule := &CompiledRule{source: source}
+	tokens := tokenize(source)
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+		name, param := t.text, ""
+		eq := strings.Index(t.text, "=")
+		if eq >= 0 {
+			name, param = t.text[:eq], t.text[eq+1:]
+		}
+
+		negate := strings.HasPrefix(name, "!")
+		if negate {
+			name = name[1:]
+		}
+
+		if name == "" {
+			return nil, &SyntaxError{Source: source, Offset: t.offset, Msg: "missing rule name"}
+		}
+
+		if name == "omitempty" && eq < 0 {
+			if negate {
+				return nil, &SyntaxError{Source: source, Offset: t.offset, Msg: "rule omitempty cannot be negated"}
+			}
+			rule.steps = append(rule.steps, ruleStep{name: name, omitEmpty: true})
+			continue
+		}
+
+		def, ok := ruleDefs[name]
+		if !ok {
+			if _, ok := lookupRule(name); !ok {
+				return nil, &SyntaxError{Source: source, Offset: t.offset, Msg: fmt.Sprintf("unknown rule %s", name)}
+			}
+
+			rule.steps = append(rule.steps, newRuleStep(name, customRule(name, param, eq >= 0), negate))
+			continue
+		}
+
+		if len(def.params) == 0 && eq >= 0 {
+			return nil, &SyntaxError{Source: source, Offset: t.offset + eq, Msg: fmt.Sprintf("rule %s takes no parameters", name)}
+		}
+
+		if len(def.params) > 0 && eq < 0 {
+			return nil, &SyntaxError{Source: source, Offset: t.offset + len(t.text), Msg: fmt.Sprintf("rule %s requires %d parameters", name, len(def.params))}
+		}
+
+		raw := make([]token, 0, len(def.params))
+		if eq >= 0 {
+			raw = append(raw, token{param, t.offset + eq + 1})
+		}
+
+		for len(raw) < len(def.params) {
+			i++
+			if i >= len(tokens) {
+				return nil, &SyntaxError{Source: source, Offset: len(source), Msg: fmt.Sprintf("rule %s requires %d parameters", name, len(def.params))}
+			}
+			raw = append(raw, tokens[i])
+		}
+
+		params := make([]interface{}, len(raw))
+		for k, p := range raw {
+			v, err := parseParam(def.params[k], p.text)
+			if err != nil {
+				return nil, &SyntaxError{Source: source, Offset: p.offset, Msg: fmt.Sprintf("invalid parameter %q for rule %s: %v", p.text, name, err)}
+			}
+			params[k] = v
+		}
+
+		rule.steps = append(rule.steps, newRuleStep(name, def.build(params), negate))
+	}
+
+	return rule, nil
+}
+
+// MustCompile is like Compile but panics if the rule string cannot be parsed
+func MustCompile(source string) *CompiledRule {
+	rule, err := Compile(source)
+	if err != nil {
+		panic(err)
+	}
+
+	return rule
+}
+
+// String returns the source rule string
+func (r *CompiledRule) String() string {
+	return r.source
+}
+
+// Apply returns true if a given value satisfies every rule of a compiled rule
+// string. Rules are checked in order and the first failure stops the check
+func (a *Assertion) Apply(rule *CompiledRule, value interface{}) bool {
+	return a.eval(func() bool {
+		for _, step := range rule.steps {
+			if step.omitEmpty {
+				if isEmpty(value) {
+					return true
+				}
+				continue
+			}
+
+			if !step.apply(a, value) {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+// newRuleStep returns the step of a given rule, negated if requested
+func newRuleStep(name string, apply applyFunc, negate bool) ruleStep {
+	if !negate {
+		return ruleStep{name: name, apply: apply}
+	}
+
+	return ruleStep{name: "!" + name, apply: func(a *Assertion, value interface{}) bool {
+		scratch := New()
+		if !apply(&scratch, value) {
+			return true
+		}
+
+		a.fail("not", value, map[string]interface{}{"Name": name})
+		return false
+	}}
+}
+
+// token is a comma separated item of a rule string and its offset
+type token struct {
+	text   string
+	offset int
+}
+
+// tokenize splits a given rule string by unescaped commas, trimming spaces
+func tokenize(source string) []token {
+	tokens := make([]token, 0)
+	if strings.TrimSpace(source) == "" {
+		return tokens
+	}
+
+	var b strings.Builder
+	start := 0
+	flush := func(end int) {
+		text := b.String()
+		trimmed := strings.TrimLeft(text, " \t")
+		tokens = append(tokens, token{strings.TrimRight(trimmed, " \t"), start + len(text) - len(trimmed)})
+		b.Reset()
+		start = end + 1
+	}
+
+	for i := 0; i < len(source); i++ {
+		switch {
+		case source[i] == '\\' && i+1 < len(source) && source[i+1] == ',':
+			b.WriteByte(',')
+			i++
+		case source[i] == ',':
+			flush(i)
+		default:
+			b.WriteByte(source[i])
+		}
+	}
+	flush(len(source))
+
+	return tokens
+}
+
+// parseParam parses a rule parameter of a given kind
+func parseParam(kind paramKind, text string) (interface{}, error) {
+	switch kind {
+	case paramNumber:
+		rat, ok := new(big.Rat).SetString(text)
+		if !ok {
+			return nil, fmt.Errorf("not a number")
+		}
+		return numberParam{text: text, rat: rat}, nil
+	case paramInt:
+		n, err := strconv.Atoi(text)
+		if err == nil && n < 0 {
+			err = fmt.Errorf("negative value")
+		}
+		return n, err
+	case paramPattern:
+		return compilePattern(text)
+	case paramList:
+		items := strings.Fields(text)
+		if len(items) == 0 {
+			return nil, fmt.Errorf("empty list")
+		}
+		return items, nil
+	}
+
+	return text, nil
+}
+
+// stringRule returns a rule asserting values as strings. Numbers and booleans
+// are asserted in their default format
+func stringRule(fn func(a *Assertion, value string) bool) applyFunc {
+	return func(a *Assertion, value interface{}) bool {
+		s, ok := toString(value)
+		if !ok {
+			a.fail("string", value, nil)
+			return false
+		}
+
+		return fn(a, s)
+	}
+}
+
+// numberParam is a number parameter of a rule string, kept exact so that large
+// integers compare without the rounding of float64
+type numberParam struct {
+	text string
+	rat  *big.Rat
+}
+
+// String returns the number as written in the rule string
+func (p numberParam) String() string {
+	return p.text
+}
+
+// numberRule returns a rule asserting values as exact numbers, so integers
+// compare as integers. Strings are parsed as numbers
+func numberRule(fn func(a *Assertion, value interface{}, n *big.Rat) bool) applyFunc {
+	return func(a *Assertion, value interface{}) bool {
+		n, ok := toRat(value)
+		if !ok {
+			a.fail("number", value, nil)
+			return false
+		}
+
+		return fn(a, value, n)
+	}
+}
+
+// customRule returns a rule applying a rule registered with RegisterRule, with
+// a given string parameter if any
+func customRule(name, param string, hasParam bool) applyFunc {
+	return func(a *Assertion, value interface{}) bool {
+		if hasParam {
+			return a.Rule(name, value, []interface{}{param})
+		}
+
+		return a.Rule(name, value, nil)
+	}
+}
+
+// toString returns a given value as a string. Numbers and booleans are returned
+// in their default format
+func toString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return fmt.Sprint(v), true
+	}
+
+	return "", false
+}
+
+// toFloat returns a given number, or string holding a number, as a float64
+func toFloat(value interface{}) (float64, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	case reflect.String:
+		f, err := strconv.ParseFloat(v.String(), 64)
+		return f, err == nil
+	}
+
+	return 0, false
+}
+
+// toRat returns a given number, or string holding a number, as an exact
+// rational number. Infinities and NaN are not numbers
+func toRat(value interface{}) (*big.Rat, bool) {
+	if n, ok := value.(json.Number); ok {
+		value = n.String()
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Rat).SetInt64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Rat).SetUint64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		if math.IsInf(v.Float(), 0) || math.IsNaN(v.Float()) {
+			return nil, false
+		}
+		return new(big.Rat).SetFloat64(v.Float()), true
+	case reflect.String:
+		if _, err := strconv.ParseFloat(v.String(), 64); err != nil {
+			return nil, false
+		}
+		return new(big.Rat).SetString(v.String())
+	}
+
+	return nil, false
+}
+
+// isEmpty returns true if a given value is nil, an empty string or an empty
+// slice, array or map. Zero numbers and false are not considered empty
+func isEmpty(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		return v.Len() == 0
+	case reflect.Ptr, reflect.Interface, reflect.Chan, reflect.Func:
+		return v.IsNil()
+	}
+
+	return false
+}
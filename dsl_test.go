@@ -0,0 +1,137 @@
+package assertion
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func init() {
+	code := regexp.MustCompile(`^[A-Z]{3}-\d{4}$`)
+	RegisterRule("productcode", func(value interface{}, params ...interface{}) bool {
+		s, ok := value.(string)
+		return ok && code.MatchString(s)
+	}, "%v is not a valid product code")
+
+	RegisterRule("withprefix", func(value interface{}, params ...interface{}) bool {
+		s, ok := value.(string)
+		return ok && strings.HasPrefix(s, params[0].(string))
+	}, "%v does not have the prefix %v")
+}
+
+func TestCompile_Apply_ReturnsTrue(t *testing.T) {
+	data := []struct {
+		rule  string
+		value interface{}
+	}{
+		{"", "anything"},
+		{"required,email,maxlen=254", "test@mail.com"},
+		{"integer,between=0,150", "42"},
+		{"integer, between=0, 150", 42},
+		{"between=0,150", 42.5},
+		{"gt=0,lt=10", int8(5)},
+		{"min=1,max=1", uint(1)},
+		{"startswith=ACC,alfanumascii", "ACC123"},
+		{`matches=^\d{3}\,\d{2}$`, "123,45"},
+		{"oneof=en es fr", "es"},
+		{"eq=1", 1.0},
+		{"omitempty,email", ""},
+		{"minlen=2", []int{1, 2}},
+		{"required", 0},
+		{"productcode", "ABC-1234"},
+		{"withprefix=INT-", "INT-01"},
+		{"!startswith=tmp-,!productcode", "acc-01"},
+		{"min=9007199254740992", int64(9007199254740992)},
+		{"max=9007199254740993", uint64(9007199254740993)},
+		{"gt=9007199254740992", "9007199254740993"},
+		{"between=-9223372036854775808,18446744073709551615", uint64(18446744073709551615)},
+		{"lt=0.3", 0.25},
+		{"!between=0,10", 11},
+	}
+
+	for _, d := range data {
+		a := New()
+		rule, err := Compile(d.rule)
+		assert.NoError(t, err, d.rule)
+		assert.True(t, a.Apply(rule, d.value), d.rule)
+		assert.False(t, a.HasErrors(), d.rule)
+	}
+}
+
+func TestCompile_Apply_ReturnsFalse(t *testing.T) {
+	data := []struct {
+		rule   string
+		value  interface{}
+		errMsg string
+	}{
+		{"required,email", "", "value is empty"},
+		{"required,email", nil, "value is empty"},
+		{"required,email,maxlen=10", "test@mail.com", "test@mail.com is longer than 10"},
+		{"integer,between=0,150", "42.5", "42.5 is not a valid integer"},
+		{"integer,between=0,150", "151", "151 is not between 0 and 150"},
+		{"between=0,150", "abc", "abc is not a valid number"},
+		{"gt=0", -1, "-1 is not greater than 0"},
+		{"email", map[string]int{}, "map[] is not a valid string"},
+		{"oneof=en es", "de", "de is not one of [en es]"},
+		{"startswith=ACC-", "INT-1", "INT-1 does not start with ACC-"},
+		{"istartswith=acc-", "INT-1", "INT-1 does not start with acc-"},
+		{`notmatches=\s`, "a b", `a b matches \s`},
+		{"omitempty,email", "abc", "abc is not a valid email"},
+		{"withprefix=ACC-", "INT-01", "INT-01 does not have the prefix ACC-"},
+		{"min=9007199254740993", int64(9007199254740992), "9007199254740992 is not greater than or equal 9007199254740993"},
+		{"lte=9223372036854775806", uint64(9223372036854775807), "9223372036854775807 is not lower than or equal 9223372036854775806"},
+		{"between=1,1.5", "1.50001", "1.50001 is not between 1 and 1.5"},
+		{"gt=0", "NaN", "NaN is not a valid number"},
+		{"required,!startswith=tmp-", "tmp-1", "tmp-1 satisfies startswith"},
+		{"!productcode", "ABC-1234", "ABC-1234 satisfies productcode"},
+		{"!between=0,10", 5, "5 satisfies between"},
+	}
+
+	for _, d := range data {
+		a := New()
+		rule := MustCompile(d.rule)
+		assert.False(t, a.Apply(rule, d.value), d.rule)
+		assert.Equal(t, 1, a.CountErrors(), d.rule)
+		assert.EqualError(t, a.ErrorAt(0), d.errMsg, d.rule)
+	}
+}
+
+func TestCompile_SyntaxError(t *testing.T) {
+	data := []struct {
+		rule   string
+		offset int
+		errMsg string
+	}{
+		{"required,emial", 9, `rule "required,emial" at offset 9: unknown rule emial`},
+		{"required,,email", 9, `rule "required,,email" at offset 9: missing rule name`},
+		{"!", 0, `rule "!" at offset 0: missing rule name`},
+		{"!omitempty,email", 0, `rule "!omitempty,email" at offset 0: rule omitempty cannot be negated`},
+		{"email=1", 5, `rule "email=1" at offset 5: rule email takes no parameters`},
+		{"maxlen", 6, `rule "maxlen" at offset 6: rule maxlen requires 1 parameters`},
+		{"maxlen=abc", 7, `rule "maxlen=abc" at offset 7: invalid parameter "abc" for rule maxlen: strconv.Atoi: parsing "abc": invalid syntax`},
+		{"between=0", 9, `rule "between=0" at offset 9: rule between requires 2 parameters`},
+		{"between=0, x", 11, `rule "between=0, x" at offset 11: invalid parameter "x" for rule between: not a number`},
+		{"gt=inf", 3, `rule "gt=inf" at offset 3: invalid parameter "inf" for rule gt: not a number`},
+		{"gt=Infinity", 3, `rule "gt=Infinity" at offset 3: invalid parameter "Infinity" for rule gt: not a number`},
+		{"between=nan,1", 8, `rule "between=nan,1" at offset 8: invalid parameter "nan" for rule between: not a number`},
+		{"matches=(", 8, "rule \"matches=(\" at offset 8: invalid parameter \"(\" for rule matches: invalid pattern: error parsing regexp: missing closing ): `(`"},
+	}
+
+	for _, d := range data {
+		rule, err := Compile(d.rule)
+		assert.Nil(t, rule, d.rule)
+
+		var syntaxErr *SyntaxError
+		assert.True(t, errors.As(err, &syntaxErr), d.rule)
+		assert.Equal(t, d.offset, syntaxErr.Offset, d.rule)
+		assert.EqualError(t, err, d.errMsg, d.rule)
+	}
+
+	assert.Panics(t, func() { MustCompile("emial") })
+}
+
+func TestCompiledRule_String(t *testing.T) {
+	assert.Equal(t, "required,email", MustCompile("required,email").String())
+}
@@ -0,0 +1,94 @@
+// Package envassert validates environment variables with the assertion rules,
+// e.g. env.Int("PORT").Between(1, 65535), reporting every missing or invalid
+// variable at once, and populates configuration structs from them.
+package envassert
+
+import (
+	"fmt"
+	"github.com/sangarbe/assertion"
+	"os"
+	"strconv"
+)
+
+const errMsgInvalidEnv = `invalid environment: %w`
+
+// LookupFunc returns the value of a given environment variable and whether it
+// is set, as os.LookupEnv does
+type LookupFunc func(name string) (string, bool)
+
+// Env is an Assertion over environment variables
+type Env struct {
+	*assertion.Assertion
+	lookup LookupFunc
+}
+
+// New creates and returns a new Env that looks up the variables with a given
+// function, or os.LookupEnv if nil, with an Assertion configured with the given
+// options
+func New(lookup LookupFunc, opts ...assertion.Option) *Env {
+	if lookup == nil {
+		lookup = os.LookupEnv
+	}
+
+	a := assertion.New(opts...)
+	return &Env{Assertion: &a, lookup: lookup}
+}
+
+// Err returns nil if every variable is valid, otherwise a single error listing
+// every missing or invalid variable
+func (e *Env) Err() error {
+	if err := e.Assertion.Err(); err != nil {
+		return fmt.Errorf(errMsgInvalidEnv, err)
+	}
+
+	return nil
+}
+
+// String returns a fluent assertion chain over a given variable
+func (e *Env) String(name string) *String {
+	return &String{variable: e.variable(name)}
+}
+
+// Bool returns a fluent assertion chain over a given variable parsed as a
+// boolean, as Assertion.Boolean accepts it
+func (e *Env) Bool(name string) *Bool {
+	b := &Bool{variable: e.variable(name)}
+	b.check(func(a *assertion.Assertion) bool { return a.Boolean(b.raw) })
+	if b.present && b.Valid() {
+		b.value, _ = strconv.ParseBool(b.raw)
+	}
+
+	return b
+}
+
+// Int returns a fluent assertion chain over a given variable parsed as an
+// integer, as Assertion.Integer accepts it
+func (e *Env) Int(name string) *Number[int] {
+	return newNumber(e.variable(name), (*assertion.Assertion).Integer, func(s string) (int, error) {
+		n, err := strconv.ParseInt(s, 0, strconv.IntSize)
+		return int(n), err
+	})
+}
+
+// Uint returns a fluent assertion chain over a given variable parsed as an
+// unsigned integer, as Assertion.Unsigned accepts it
+func (e *Env) Uint(name string) *Number[uint] {
+	return newNumber(e.variable(name), (*assertion.Assertion).Unsigned, func(s string) (uint, error) {
+		n, err := strconv.ParseUint(s, 0, strconv.IntSize)
+		return uint(n), err
+	})
+}
+
+// Float returns a fluent assertion chain over a given variable parsed as a
+// float, as Assertion.Float accepts it
+func (e *Env) Float(name string) *Number[float64] {
+	return newNumber(e.variable(name), (*assertion.Assertion).Float, func(s string) (float64, error) {
+		return strconv.ParseFloat(s, 64)
+	})
+}
+
+// variable returns the common state of an assertion chain over a given variable
+func (e *Env) variable(name string) variable {
+	raw, ok := e.lookup(name)
+	return variable{Chain: assertion.NewChain(e.Assertion, name), name: name, raw: raw, present: ok && raw != ""}
+}
@@ -0,0 +1,152 @@
+package envassert
+
+import (
+	"errors"
+	"github.com/sangarbe/assertion"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func lookup(vars map[string]string) LookupFunc {
+	return func(name string) (string, bool) {
+		v, ok := vars[name]
+		return v, ok
+	}
+}
+
+func TestEnv(t *testing.T) {
+	env := New(lookup(map[string]string{
+		"DATABASE_URL": "postgres://user@db:5432/app",
+		"PORT":         "8080",
+		"DEBUG":        "true",
+		"RATIO":        "0.5",
+		"WORKERS":      "0x10",
+	}))
+
+	assert.Equal(t, "postgres://user@db:5432/app", env.String("DATABASE_URL").Required().URL().Value())
+	assert.Equal(t, 8080, env.Int("PORT").Between(1, 65535).Value())
+	assert.Equal(t, uint(16), env.Uint("WORKERS").Max(64).Value())
+	assert.Equal(t, 0.5, env.Float("RATIO").Between(0, 1).Value())
+	assert.True(t, env.Bool("DEBUG").Value())
+	assert.Equal(t, "info", env.String("LOG_LEVEL").Default("info").OneOf("debug", "info").Value())
+	assert.Equal(t, 30, env.Int("TIMEOUT").Default(30).Min(1).Value())
+	assert.NoError(t, env.Err())
+}
+
+func TestEnv_Err(t *testing.T) {
+	env := New(lookup(map[string]string{
+		"DATABASE_URL": "",
+		"PORT":         "70000",
+		"DEBUG":        "maybe",
+		"API_URL":      "localhost",
+	}))
+
+	assert.False(t, env.String("DATABASE_URL").Required().URL().Valid())
+	assert.False(t, env.Int("PORT").Between(1, 65535).Valid())
+	assert.False(t, env.Bool("DEBUG").Valid())
+	assert.False(t, env.String("API_URL").URL().Valid())
+	assert.False(t, env.Int("WORKERS").Required().Valid())
+
+	err := env.Err()
+	assert.EqualError(t, err, "invalid environment: "+
+		"DATABASE_URL: value is empty; "+
+		"PORT: 70000 is not between 1 and 65535; "+
+		"DEBUG: maybe is not a valid boolean string; "+
+		"API_URL: localhost is not a valid url; "+
+		"WORKERS: value is empty")
+
+	var e *assertion.Error
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, "DATABASE_URL", e.Field)
+}
+
+func TestEnv_Populate(t *testing.T) {
+	type Database struct {
+		URL      string `env:"DATABASE_URL,required" rule:"url"`
+		MaxConns uint8  `env:"DATABASE_MAX_CONNS" default:"10"`
+	}
+
+	var cfg struct {
+		Database
+		Port    int     `env:"PORT" default:"8080" rule:"between=1,65535"`
+		Debug   bool    `env:"DEBUG"`
+		Ratio   float64 `env:"RATIO"`
+		Name    string  `env:"NAME"`
+		ignored string
+	}
+	cfg.Name = "app"
+
+	env := New(lookup(map[string]string{
+		"DATABASE_URL": "postgres://db/app",
+		"DEBUG":        "1",
+		"RATIO":        "0.25",
+	}))
+
+	assert.NoError(t, env.Populate(&cfg))
+	assert.Equal(t, "postgres://db/app", cfg.URL)
+	assert.Equal(t, uint8(10), cfg.MaxConns)
+	assert.Equal(t, 8080, cfg.Port)
+	assert.True(t, cfg.Debug)
+	assert.Equal(t, 0.25, cfg.Ratio)
+	assert.Equal(t, "app", cfg.Name)
+}
+
+func TestEnv_Populate_Errors(t *testing.T) {
+	var cfg struct {
+		URL      string  `env:"DATABASE_URL,required" rule:"url"`
+		MaxConns uint8   `env:"DATABASE_MAX_CONNS"`
+		Port     int     `env:"PORT" rule:"between=1,65535"`
+		Debug    bool    `env:"DEBUG"`
+		Ratio    float32 `env:"RATIO"`
+	}
+	cfg.Port = 8080
+
+	env := New(lookup(map[string]string{
+		"DATABASE_MAX_CONNS": "300",
+		"PORT":               "0",
+		"DEBUG":              "yes",
+		"RATIO":              "1e40",
+	}))
+
+	err := env.Populate(&cfg)
+	assert.EqualError(t, err, "invalid environment: "+
+		"DATABASE_URL: value is empty; "+
+		`DATABASE_MAX_CONNS: strconv.ParseUint: parsing "300": value out of range; `+
+		"PORT: 0 is not between 1 and 65535; "+
+		"DEBUG: yes is not a valid boolean string; "+
+		`RATIO: strconv.ParseFloat: parsing "1e40": value out of range`)
+	assert.Equal(t, uint8(0), cfg.MaxConns)
+	assert.Equal(t, float32(0), cfg.Ratio)
+	assert.Equal(t, 0, cfg.Port)
+}
+
+func TestEnv_Populate_TagOptions(t *testing.T) {
+	env := New(lookup(map[string]string{}))
+
+	var cfg struct {
+		Token string `env:"TOKEN, required,required"`
+	}
+	assert.EqualError(t, env.Populate(&cfg), "invalid environment: TOKEN: value is empty")
+
+	var bad struct {
+		Nested struct {
+			Token string `env:"TOKEN,required,secret"`
+		}
+	}
+	env = New(lookup(map[string]string{"TOKEN": "abc"}))
+	assert.EqualError(t, env.Populate(&bad), `envassert: unknown option "secret" in the env tag of field Token`)
+	assert.Equal(t, "", bad.Nested.Token)
+	assert.False(t, env.HasErrors())
+}
+
+func TestEnv_Populate_Panics(t *testing.T) {
+	env := New(lookup(map[string]string{"HOSTS": "a,b"}))
+
+	assert.Panics(t, func() { _ = env.Populate(struct{}{}) })
+	assert.Panics(t, func() {
+		var cfg struct {
+			Hosts []string `env:"HOSTS"`
+		}
+		_ = env.Populate(&cfg)
+	})
+}
@@ -0,0 +1,171 @@
+package envassert
+
+import (
+	"errors"
+	"fmt"
+	"github.com/sangarbe/assertion"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	errMsgNotStructPointer = `envassert: %T is not a pointer to a struct`
+	errMsgUnsupportedField = `envassert: field %v of kind %v is not supported`
+	errMsgUnknownOption    = `envassert: unknown option %q in the env tag of field %v`
+)
+
+// Populate sets the fields of the struct pointed by v from the variables named
+// by their env tag, and returns the same error as Err. Tags admit options after
+// the name, and default values and rules in their own tags, e.g.
+//
+//	type Config struct {
+//		DatabaseURL string `env:"DATABASE_URL,required" rule:"url"`
+//		Port        int    `env:"PORT" default:"8080" rule:"between=1,65535"`
+//		Debug       bool   `env:"DEBUG"`
+//	}
+//
+// The only option is required. Missing variables keep the field value unless
+// they have a default. Fields of kinds other than string, bool and numbers, and
+// invalid rules, panic. An unknown option is returned as error, without setting
+// any field. Nested structs without env tag are populated too
+func (e *Env) Populate(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		panic(fmt.Errorf(errMsgNotStructPointer, v))
+	}
+
+	if err := checkTags(rv.Elem().Type()); err != nil {
+		return err
+	}
+
+	e.populate(rv.Elem())
+	return e.Err()
+}
+
+// checkTags returns an error if the env tag of some field of a given struct
+// type, or of its nested structs, has an unknown option
+func checkTags(rt reflect.Type) error {
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		tag, ok := f.Tag.Lookup("env")
+		if !ok {
+			if f.Type.Kind() == reflect.Struct {
+				if err := checkTags(f.Type); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if _, _, err := parseTag(f, tag); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseTag returns the variable name of the env tag of a given field and whether
+// it has the required option, or an error if it has an unknown option
+func parseTag(f reflect.StructField, tag string) (name string, required bool, err error) {
+	name, opts, _ := strings.Cut(tag, ",")
+	if opts == "" {
+		return name, false, nil
+	}
+
+	for _, opt := range strings.Split(opts, ",") {
+		switch opt = strings.TrimSpace(opt); opt {
+		case "required":
+			required = true
+		default:
+			return "", false, fmt.Errorf(errMsgUnknownOption, opt, f.Name)
+		}
+	}
+
+	return name, required, nil
+}
+
+// populate sets the fields of a given struct value, whose tags are checked
+func (e *Env) populate(rv reflect.Value) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		tag, ok := f.Tag.Lookup("env")
+		if !ok {
+			if f.Type.Kind() == reflect.Struct {
+				e.populate(rv.Field(i))
+			}
+			continue
+		}
+
+		name, required, _ := parseTag(f, tag)
+		v := e.variable(name)
+		if !v.present {
+			def, ok := f.Tag.Lookup("default")
+			if !ok {
+				if required {
+					v.required()
+				}
+				continue
+			}
+			v.raw, v.present = def, true
+		}
+
+		if !setField(&v, rv.Field(i)) {
+			continue
+		}
+
+		if rule, ok := f.Tag.Lookup("rule"); ok {
+			r := assertion.MustCompile(rule)
+			v.check(func(a *assertion.Assertion) bool { return a.Apply(r, rv.Field(i).Interface()) })
+		}
+	}
+}
+
+// setField sets a given field from the raw value of a given variable, parsed by
+// the kind of the field. It returns false, keeping the field value, if the raw
+// value is not valid for the kind
+func setField(v *variable, field reflect.Value) bool {
+	var set func()
+	switch field.Kind() {
+	case reflect.String:
+		set = func() { field.SetString(v.raw) }
+	case reflect.Bool:
+		v.check(func(a *assertion.Assertion) bool { return a.Boolean(v.raw) })
+		b, _ := strconv.ParseBool(v.raw)
+		set = func() { field.SetBool(b) }
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.check(func(a *assertion.Assertion) bool { return a.Integer(v.raw) })
+		n, err := strconv.ParseInt(v.raw, 0, field.Type().Bits())
+		v.check(func(a *assertion.Assertion) bool { return a.Check(!errors.Is(err, strconv.ErrRange), err) })
+		set = func() { field.SetInt(n) }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.check(func(a *assertion.Assertion) bool { return a.Unsigned(v.raw) })
+		n, err := strconv.ParseUint(v.raw, 0, field.Type().Bits())
+		v.check(func(a *assertion.Assertion) bool { return a.Check(!errors.Is(err, strconv.ErrRange), err) })
+		set = func() { field.SetUint(n) }
+	case reflect.Float32, reflect.Float64:
+		v.check(func(a *assertion.Assertion) bool { return a.Float(v.raw) })
+		n, err := strconv.ParseFloat(v.raw, field.Type().Bits())
+		v.check(func(a *assertion.Assertion) bool { return a.Check(!errors.Is(err, strconv.ErrRange), err) })
+		set = func() { field.SetFloat(n) }
+	default:
+		panic(fmt.Errorf(errMsgUnsupportedField, v.name, field.Kind()))
+	}
+
+	if !v.Valid() {
+		return false
+	}
+
+	set()
+	return true
+}
@@ -0,0 +1,187 @@
+package envassert
+
+import (
+	"github.com/sangarbe/assertion"
+)
+
+// variable holds the common state of the assertion chains over a variable.
+// Empty variables are considered missing: they are only checked by Required,
+// any other assertion is skipped. Once an assertion fails, subsequent
+// assertions are skipped too
+type variable struct {
+	assertion.Chain
+	name    string
+	raw     string
+	present bool
+}
+
+// check runs a given assertion on the chain unless the variable is missing
+func (v *variable) check(fn func(a *assertion.Assertion) bool) {
+	if v.present {
+		v.Check(fn)
+	}
+}
+
+// required records a required error unless the variable is present or the
+// chain has already failed
+func (v *variable) required(msgArgs ...interface{}) {
+	v.Check(func(a *assertion.Assertion) bool {
+		if v.present {
+			return true
+		}
+
+		a.AddError(assertion.NewError("required", v.raw, nil, msgArgs...))
+		return false
+	})
+}
+
+// String is a fluent assertion chain over a variable
+type String struct {
+	variable
+	def string
+}
+
+// Value returns the value of the variable, or the default value if it is missing
+func (s *String) Value() string {
+	if !s.present {
+		return s.def
+	}
+
+	return s.raw
+}
+
+// Default sets the value returned by Value if the variable is missing
+func (s *String) Default(value string) *String {
+	s.def = value
+	return s
+}
+
+// Required asserts that the variable is set and not empty
+func (s *String) Required(msgArgs ...interface{}) *String {
+	s.required(msgArgs...)
+	return s
+}
+
+// URL asserts that the variable is a valid absolute url
+func (s *String) URL(msgArgs ...interface{}) *String {
+	s.check(func(a *assertion.Assertion) bool { return a.URL(s.raw, msgArgs...) })
+	return s
+}
+
+// Email asserts that the variable is a valid email
+func (s *String) Email(msgArgs ...interface{}) *String {
+	s.check(func(a *assertion.Assertion) bool { return a.Email(s.raw, msgArgs...) })
+	return s
+}
+
+// OneOf asserts that the variable is equal to some of the given options
+func (s *String) OneOf(options ...string) *String {
+	s.check(func(a *assertion.Assertion) bool { return assertion.OneOf(a, s.raw, options) })
+	return s
+}
+
+// Matches asserts that the variable matches a given pattern
+func (s *String) Matches(pattern interface{}, msgArgs ...interface{}) *String {
+	s.check(func(a *assertion.Assertion) bool { return a.Matches(s.raw, pattern, msgArgs...) })
+	return s
+}
+
+// MinLength asserts that the variable has at least n runes
+func (s *String) MinLength(n int, msgArgs ...interface{}) *String {
+	s.check(func(a *assertion.Assertion) bool { return a.MinLength(s.raw, n, msgArgs...) })
+	return s
+}
+
+// Apply asserts that the variable satisfies a compiled rule string
+func (s *String) Apply(rule *assertion.CompiledRule) *String {
+	s.check(func(a *assertion.Assertion) bool { return a.Apply(rule, s.raw) })
+	return s
+}
+
+// Bool is a fluent assertion chain over a variable parsed as a boolean
+type Bool struct {
+	variable
+	value bool
+}
+
+// Value returns the value of the variable, false or the default value if it is
+// missing or invalid
+func (b *Bool) Value() bool {
+	return b.value
+}
+
+// Default sets the value returned by Value if the variable is missing
+func (b *Bool) Default(value bool) *Bool {
+	if !b.present {
+		b.value = value
+	}
+
+	return b
+}
+
+// Required asserts that the variable is set and not empty
+func (b *Bool) Required(msgArgs ...interface{}) *Bool {
+	b.required(msgArgs...)
+	return b
+}
+
+// Number is a fluent assertion chain over a variable parsed as a number
+type Number[T int | uint | float64] struct {
+	variable
+	value T
+}
+
+// newNumber returns a fluent assertion chain over a given variable, checked with
+// a given assertion method and parsed with a given function
+func newNumber[T int | uint | float64](v variable, is func(a *assertion.Assertion, value string, msgArgs ...interface{}) bool, parse func(s string) (T, error)) *Number[T] {
+	n := &Number[T]{variable: v}
+	n.check(func(a *assertion.Assertion) bool { return is(a, n.raw) })
+	if n.present && n.Valid() {
+		var err error
+		if n.value, err = parse(n.raw); err != nil {
+			n.check(func(a *assertion.Assertion) bool { return a.Check(false, err) })
+		}
+	}
+
+	return n
+}
+
+// Value returns the value of the variable, zero or the default value if it is
+// missing or invalid
+func (n *Number[T]) Value() T {
+	return n.value
+}
+
+// Default sets the value returned by Value if the variable is missing
+func (n *Number[T]) Default(value T) *Number[T] {
+	if !n.present {
+		n.value = value
+	}
+
+	return n
+}
+
+// Required asserts that the variable is set and not empty
+func (n *Number[T]) Required(msgArgs ...interface{}) *Number[T] {
+	n.required(msgArgs...)
+	return n
+}
+
+// Between asserts that the variable is between a lower and upper limit values
+// (including both)
+func (n *Number[T]) Between(lower, upper T, msgArgs ...interface{}) *Number[T] {
+	n.check(func(a *assertion.Assertion) bool { return assertion.Between(a, n.value, lower, upper, msgArgs...) })
+	return n
+}
+
+// Min asserts that the variable is greater than or equal to a given value
+func (n *Number[T]) Min(min T, msgArgs ...interface{}) *Number[T] {
+	n.check(func(a *assertion.Assertion) bool { return assertion.GreaterThanOrEqual(a, n.value, min, msgArgs...) })
+	return n
+}
+
+// Max asserts that the variable is lower than or equal to a given value
+func (n *Number[T]) Max(max T, msgArgs ...interface{}) *Number[T] {
+	n.check(func(a *assertion.Assertion) bool { return assertion.LowerThanOrEqual(a, n.value, max, msgArgs...) })
+	return n
+}
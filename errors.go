@@ -0,0 +1,140 @@
+package assertion
+
+import (
+	"fmt"
+)
+
+// Error is the error recorded by a failed assertion. Besides the message, it
+// keeps the data of the failure: the rule that failed, the field name if the
+// value was named, the asserted value and the rule parameters by name. The
+// message is rendered from the rule template, see SetMessages
+type Error struct {
+	Rule   string
+	Field  string
+	Value  interface{}
+	Params map[string]interface{}
+
+	msgArgs    []interface{}
+	args       []interface{}
+	errs       []error
+	locale     string
+	translator Translator
+}
+
+// NewError returns the error of a failed assertion of a given rule over a value,
+// with the given rule parameters by name. msgArgs customize the message as
+// documented in Assertion. Packages extending Assertion record it with AddError.
+// It panics if msgArgs has arguments for a format that is not a string
+func NewError(rule string, value interface{}, params map[string]interface{}, msgArgs ...interface{}) *Error {
+	if len(msgArgs) > 1 {
+		if _, ok := msgArgs[0].(string); !ok {
+			panic(fmt.Errorf(errMsgFormat, msgArgs[0]))
+		}
+	}
+
+	return &Error{Rule: rule, Value: value, Params: params, msgArgs: msgArgs}
+}
+
+// Error returns the error message prefixed with the field name, unless the
+// message template already places the field. The message is in the locale of
+// the Assertion that recorded the error, see WithLocale
+func (e *Error) Error() string {
+	return e.Localize(e.locale)
+}
+
+// Message returns the error message without the field name prefix
+func (e *Error) Message() string {
+	msg, _ := e.render(e.template(e.locale), e.locale)
+	return msg
+}
+
+// Localize returns the error message in a given locale, e.g. "de" or "pt-BR",
+// prefixed with the field name as Error does. Custom messages given as msgArgs
+// are not translated
+func (e *Error) Localize(locale string) string {
+	msg, fieldUsed := e.render(e.template(locale), locale)
+	if e.Field == "" || fieldUsed {
+		return msg
+	}
+
+	return fmt.Sprintf(errMsgField, e.Field, msg)
+}
+
+// Unwrap returns the errors this error is made of, if any
+func (e *Error) Unwrap() []error {
+	return e.errs
+}
+
+// template returns the message template of the error rule in a given locale.
+// The Translator of the error comes first, then the built-in catalogues. English
+// and unknown locales use the default templates, including those set with
+// SetMessages
+func (e *Error) template(locale string) string {
+	if locale == "" {
+		return messageTemplate(e.Rule)
+	}
+
+	if e.translator != nil {
+		if template, ok := e.translator.Translate(locale, e.Rule); ok {
+			return template
+		}
+	}
+
+	if lang := localeCandidates(locale); lang[len(lang)-1] != "en" {
+		if template, ok := builtinTemplate(locale, e.Rule); ok {
+			return template
+		}
+	}
+
+	return messageTemplate(e.Rule)
+}
+
+// render returns the error message built from a given rule template, and
+// whether the template references the field name. Custom messages given as
+// msgArgs replace the rule template: a single string with placeholders is
+// rendered as a template, any other msgArgs are formatted as they always were.
+// Templates of rules registered with a format, see RegisterRule, are formatted
+// with the value and parameters unless they have placeholders. Nested errors
+// are rendered in a given locale
+func (e *Error) render(template, locale string) (string, bool) {
+	switch {
+	case len(e.msgArgs) > 1:
+		return fmt.Sprintf(e.msgArgs[0].(string), e.msgArgs[1:]...), false
+	case len(e.msgArgs) == 1:
+		s, ok := e.msgArgs[0].(string)
+		if !ok || !hasPlaceholders(s) {
+			return fmt.Sprintf("%+v", e.msgArgs[0]), false
+		}
+		template = s
+	case e.args != nil && !hasPlaceholders(template):
+		return fmt.Sprintf(template, e.args...), false
+	}
+
+	return renderTemplate(template, e.data(locale)), referencesField(template)
+}
+
+// data returns the values available to message templates, with nested errors
+// rendered in a given locale
+func (e *Error) data(locale string) map[string]interface{} {
+	data := make(map[string]interface{}, len(e.Params)+3)
+	for k, v := range e.Params {
+		if l, ok := v.(errorList); ok {
+			v = l.localize(locale)
+		}
+		data[k] = v
+	}
+	data["Value"] = e.Value
+	data["Field"] = e.Field
+	data["Rule"] = e.Rule
+
+	return data
+}
+
+// withField returns a copy of the error for a given field. Nested fields are
+// joined into a path, e.g. "address.street" or "items[0]"
+func (e *Error) withField(field string) *Error {
+	c := *e
+	c.Field = joinPath(field, e.Field)
+
+	return &c
+}
@@ -0,0 +1,87 @@
+// Package flagassert provides flag.Value implementations that declare assertion
+// rules, e.g. fs.Var(flagassert.String(&host).Hostname(), "host", "server host"),
+// and a Parse function that reports every invalid flag at once.
+package flagassert
+
+import (
+	"flag"
+	"fmt"
+	"github.com/sangarbe/assertion"
+	"os"
+)
+
+const errMsgInvalidFlags = `invalid flags: %w`
+
+// validator is a flag value that checks its rules once the flags are parsed
+type validator interface {
+	validate(a *assertion.Assertion, name string)
+}
+
+// Parse parses the given arguments with a flag set, as FlagSet.Parse does, and
+// then checks the rules of every flag value of this package. Arguments that are
+// not valid numbers are reported by the flag set, as for its own flags. Else
+// it returns a single error listing every invalid flag, handled according to
+// the error handling of the flag set. The options configure the Assertion of
+// the check, e.g. assertion.WithLocale
+func Parse(fs *flag.FlagSet, args []string, opts ...assertion.Option) error {
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	a := assertion.New(opts...)
+	fs.VisitAll(func(f *flag.Flag) {
+		if v, ok := f.Value.(validator); ok {
+			v.validate(&a, "-"+f.Name)
+		}
+	})
+
+	err := a.Err()
+	if err == nil {
+		return nil
+	}
+
+	err = fmt.Errorf(errMsgInvalidFlags, err)
+	switch fs.ErrorHandling() {
+	case flag.ExitOnError:
+		fmt.Fprintln(fs.Output(), err)
+		fs.Usage()
+		os.Exit(2)
+	case flag.PanicOnError:
+		panic(err)
+	}
+
+	return err
+}
+
+// value holds the common state of the flag values: the raw argument and the
+// rules to check. Values of flags not given are only checked by Required
+type value struct {
+	raw      string
+	set      bool
+	required bool
+	checks   []func(a *assertion.Assertion) bool
+}
+
+// is adds a given rule to the value
+func (v *value) is(check func(a *assertion.Assertion) bool) {
+	v.checks = append(v.checks, check)
+}
+
+// validate checks the rules of the value until the first failure, adding the
+// errors to a given Assertion prefixed by the flag name
+func (v *value) validate(a *assertion.Assertion, name string) {
+	c := assertion.NewChain(a, name)
+	if !v.set || v.raw == "" {
+		if v.required {
+			c.Check(func(a *assertion.Assertion) bool {
+				a.AddError(assertion.NewError("required", v.raw, nil))
+				return false
+			})
+		}
+		return
+	}
+
+	for _, check := range v.checks {
+		c.Check(check)
+	}
+}
@@ -0,0 +1,108 @@
+package flagassert
+
+import (
+	"bytes"
+	"flag"
+	"github.com/sangarbe/assertion"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type flags struct {
+	host    string
+	port    int
+	workers uint
+	ratio   float64
+	mode    string
+}
+
+func newFlagSet(f *flags) *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(new(bytes.Buffer))
+
+	f.port = 8080
+	fs.Var(String(&f.host).Required().Hostname(), "host", "server host")
+	fs.Var(Int(&f.port).Between(1, 65535), "port", "server port")
+	fs.Var(Uint(&f.workers).Max(64), "workers", "number of workers")
+	fs.Var(Float64(&f.ratio).Between(0, 1), "ratio", "sampling ratio")
+	fs.Var(String(&f.mode).OneOf("dev", "prod"), "mode", "run mode")
+
+	return fs
+}
+
+func TestParse(t *testing.T) {
+	var f flags
+	fs := newFlagSet(&f)
+
+	assert.NoError(t, Parse(fs, []string{"-host", "db-1.example.com", "-workers", "0x10", "-ratio", "0.5", "-mode", "prod"}))
+	assert.Equal(t, flags{host: "db-1.example.com", port: 8080, workers: 16, ratio: 0.5, mode: "prod"}, f)
+}
+
+func TestParse_InvalidFlags(t *testing.T) {
+	var f flags
+	fs := newFlagSet(&f)
+
+	err := Parse(fs, []string{"-host", "foo_bar", "-port", "0", "-workers", "65", "-ratio", "1.5", "-mode", "test"})
+	assert.EqualError(t, err, "invalid flags: "+
+		"-host: foo_bar is not a valid hostname; "+
+		"-mode: test is not one of [dev prod]; "+
+		"-port: 0 is not between 1 and 65535; "+
+		"-ratio: 1.5 is not between 0 and 1; "+
+		"-workers: 65 is not lower than or equal 64")
+}
+
+func TestNumberValue_Set(t *testing.T) {
+	data := []struct {
+		args   []string
+		errMsg string
+	}{
+		{[]string{"-workers", "-1"}, `invalid value "-1" for flag -workers: -1 is not a valid unsigned integer`},
+		{[]string{"-ratio", "abc"}, `invalid value "abc" for flag -ratio: abc is not a valid float`},
+		{[]string{"-port", "99999999999999999999"}, `invalid value "99999999999999999999" for flag -port: 99999999999999999999 is not a valid integer`},
+	}
+
+	for _, d := range data {
+		var f flags
+		fs := newFlagSet(&f)
+
+		assert.EqualError(t, fs.Parse(d.args), d.errMsg)
+		assert.EqualError(t, Parse(newFlagSet(&f), d.args), d.errMsg)
+		assert.Equal(t, 8080, f.port)
+	}
+}
+
+func TestParse_Required(t *testing.T) {
+	var f flags
+	fs := newFlagSet(&f)
+
+	err := Parse(fs, []string{"-port", "0"}, assertion.WithLocale("es"))
+	assert.EqualError(t, err, "invalid flags: "+
+		"-host: el valor está vacío; "+
+		"-port: 0 no está entre 1 y 65535")
+	assert.Equal(t, 0, f.port)
+}
+
+func TestParse_FlagSetError(t *testing.T) {
+	var f flags
+	fs := newFlagSet(&f)
+
+	assert.EqualError(t, Parse(fs, []string{"-unknown"}), "flag provided but not defined: -unknown")
+}
+
+func TestParse_PanicOnError(t *testing.T) {
+	var host string
+	fs := flag.NewFlagSet("test", flag.PanicOnError)
+	fs.Var(String(&host).Hostname(), "host", "server host")
+
+	assert.Panics(t, func() { _ = Parse(fs, []string{"-host", "foo_bar"}) })
+}
+
+func TestValue_PrintDefaults(t *testing.T) {
+	var f flags
+	fs := newFlagSet(&f)
+	out := new(bytes.Buffer)
+	fs.SetOutput(out)
+
+	fs.PrintDefaults()
+	assert.Contains(t, out.String(), "server port (default 8080)")
+}
@@ -0,0 +1,204 @@
+package flagassert
+
+import (
+	"fmt"
+	"github.com/sangarbe/assertion"
+	"strconv"
+)
+
+// StringValue is a string flag value with rules
+type StringValue struct {
+	value
+	p *string
+}
+
+// String returns a flag value that sets a given string and checks the rules
+// declared with its methods
+func String(p *string) *StringValue {
+	return &StringValue{p: p}
+}
+
+// String returns the current value of the flag
+func (s *StringValue) String() string {
+	if s == nil || s.p == nil {
+		return ""
+	}
+
+	return *s.p
+}
+
+// Set sets the flag from a given argument. Rules are checked by Parse
+func (s *StringValue) Set(arg string) error {
+	s.raw, s.set = arg, true
+	*s.p = arg
+	return nil
+}
+
+// Required asserts that the flag is given and not empty
+func (s *StringValue) Required() *StringValue {
+	s.required = true
+	return s
+}
+
+// Hostname asserts that the flag is a valid host name
+func (s *StringValue) Hostname(msgArgs ...interface{}) *StringValue {
+	s.is(func(a *assertion.Assertion) bool { return a.Hostname(s.raw, msgArgs...) })
+	return s
+}
+
+// URL asserts that the flag is a valid absolute url
+func (s *StringValue) URL(msgArgs ...interface{}) *StringValue {
+	s.is(func(a *assertion.Assertion) bool { return a.URL(s.raw, msgArgs...) })
+	return s
+}
+
+// Email asserts that the flag is a valid email
+func (s *StringValue) Email(msgArgs ...interface{}) *StringValue {
+	s.is(func(a *assertion.Assertion) bool { return a.Email(s.raw, msgArgs...) })
+	return s
+}
+
+// Ipv4 asserts that the flag is a valid ipv4
+func (s *StringValue) Ipv4(msgArgs ...interface{}) *StringValue {
+	s.is(func(a *assertion.Assertion) bool { return a.Ipv4(s.raw, msgArgs...) })
+	return s
+}
+
+// OneOf asserts that the flag is equal to some of the given options
+func (s *StringValue) OneOf(options ...string) *StringValue {
+	s.is(func(a *assertion.Assertion) bool { return assertion.OneOf(a, s.raw, options) })
+	return s
+}
+
+// Matches asserts that the flag matches a given pattern
+func (s *StringValue) Matches(pattern interface{}, msgArgs ...interface{}) *StringValue {
+	s.is(func(a *assertion.Assertion) bool { return a.Matches(s.raw, pattern, msgArgs...) })
+	return s
+}
+
+// MinLength asserts that the flag has at least n runes
+func (s *StringValue) MinLength(n int, msgArgs ...interface{}) *StringValue {
+	s.is(func(a *assertion.Assertion) bool { return a.MinLength(s.raw, n, msgArgs...) })
+	return s
+}
+
+// MaxLength asserts that the flag has at most n runes
+func (s *StringValue) MaxLength(n int, msgArgs ...interface{}) *StringValue {
+	s.is(func(a *assertion.Assertion) bool { return a.MaxLength(s.raw, n, msgArgs...) })
+	return s
+}
+
+// Apply asserts that the flag satisfies a compiled rule string
+func (s *StringValue) Apply(rule *assertion.CompiledRule) *StringValue {
+	s.is(func(a *assertion.Assertion) bool { return a.Apply(rule, s.raw) })
+	return s
+}
+
+// NumberValue is a number flag value with rules
+type NumberValue[T int | int64 | uint | uint64 | float64] struct {
+	value
+	p     *T
+	valid func(a *assertion.Assertion, value string, msgArgs ...interface{}) bool
+	parse func(arg string) (T, error)
+}
+
+// Int returns a flag value that sets a given int, parsed as Assertion.Integer
+// accepts it, and checks the rules declared with its methods
+func Int(p *int) *NumberValue[int] {
+	return newNumber(p, (*assertion.Assertion).Integer, func(arg string) (int, error) {
+		n, err := strconv.ParseInt(arg, 0, strconv.IntSize)
+		return int(n), err
+	})
+}
+
+// Int64 returns a flag value that sets a given int64, parsed as
+// Assertion.Integer accepts it, and checks the rules declared with its methods
+func Int64(p *int64) *NumberValue[int64] {
+	return newNumber(p, (*assertion.Assertion).Integer, func(arg string) (int64, error) {
+		return strconv.ParseInt(arg, 0, 64)
+	})
+}
+
+// Uint returns a flag value that sets a given uint, parsed as
+// Assertion.Unsigned accepts it, and checks the rules declared with its methods
+func Uint(p *uint) *NumberValue[uint] {
+	return newNumber(p, (*assertion.Assertion).Unsigned, func(arg string) (uint, error) {
+		n, err := strconv.ParseUint(arg, 0, strconv.IntSize)
+		return uint(n), err
+	})
+}
+
+// Uint64 returns a flag value that sets a given uint64, parsed as
+// Assertion.Unsigned accepts it, and checks the rules declared with its methods
+func Uint64(p *uint64) *NumberValue[uint64] {
+	return newNumber(p, (*assertion.Assertion).Unsigned, func(arg string) (uint64, error) {
+		return strconv.ParseUint(arg, 0, 64)
+	})
+}
+
+// Float64 returns a flag value that sets a given float64, parsed as
+// Assertion.Float accepts it, and checks the rules declared with its methods
+func Float64(p *float64) *NumberValue[float64] {
+	return newNumber(p, (*assertion.Assertion).Float, func(arg string) (float64, error) {
+		return strconv.ParseFloat(arg, 64)
+	})
+}
+
+// newNumber returns a number flag value over a given pointer, whose arguments
+// are checked by a given assertion method and parsed by a given function
+func newNumber[T int | int64 | uint | uint64 | float64](p *T, is func(a *assertion.Assertion, value string, msgArgs ...interface{}) bool, parse func(arg string) (T, error)) *NumberValue[T] {
+	return &NumberValue[T]{p: p, valid: is, parse: parse}
+}
+
+// String returns the current value of the flag
+func (n *NumberValue[T]) String() string {
+	if n == nil || n.p == nil {
+		return ""
+	}
+
+	return fmt.Sprint(*n.p)
+}
+
+// Set sets the flag from a given argument, or returns an error if it is not a
+// valid number, which the flag set reports as it does for its own flags. Rules
+// are checked by Parse
+func (n *NumberValue[T]) Set(arg string) error {
+	a := assertion.New()
+	if !n.valid(&a, arg) {
+		return a.ErrorAt(0)
+	}
+
+	v, err := n.parse(arg)
+	if err != nil {
+		return err
+	}
+
+	n.raw, n.set = arg, true
+	*n.p = v
+	return nil
+}
+
+// Required asserts that the flag is given
+func (n *NumberValue[T]) Required() *NumberValue[T] {
+	n.required = true
+	return n
+}
+
+// Between asserts that the flag is between a lower and upper limit values
+// (including both)
+func (n *NumberValue[T]) Between(lower, upper T, msgArgs ...interface{}) *NumberValue[T] {
+	n.is(func(a *assertion.Assertion) bool { return assertion.Between(a, *n.p, lower, upper, msgArgs...) })
+	return n
+}
+
+// Min asserts that the flag is greater than or equal to a given value
+func (n *NumberValue[T]) Min(min T, msgArgs ...interface{}) *NumberValue[T] {
+	n.is(func(a *assertion.Assertion) bool { return assertion.GreaterThanOrEqual(a, *n.p, min, msgArgs...) })
+	return n
+}
+
+// Max asserts that the flag is lower than or equal to a given value
+func (n *NumberValue[T]) Max(max T, msgArgs ...interface{}) *NumberValue[T] {
+	n.is(func(a *assertion.Assertion) bool { return assertion.LowerThanOrEqual(a, *n.p, max, msgArgs...) })
+	return n
+}
@@ -0,0 +1,445 @@
+package assertion
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+)
+
+// fieldError is an error, other than *Error, produced by an assertion over a
+// named value
+type fieldError struct {
+	field string
+	err   error
+}
+
+// newFieldError returns a given error as an error of a given field. Errors of
+// nested fields are joined into a path, e.g. "address.street" or "items[0]"
+func newFieldError(field string, err error) error {
+	switch e := err.(type) {
+	case *Error:
+		return e.withField(field)
+	case *fieldError:
+		return &fieldError{field: joinPath(field, e.field), err: e.err}
+	}
+
+	return &fieldError{field: field, err: err}
+}
+
+// joinPath joins a parent and a child field names. Index fields are appended
+// without a dot separator
+func joinPath(parent, child string) string {
+	if parent == "" {
+		return child
+	}
+
+	if child == "" {
+		return parent
+	}
+
+	if strings.HasPrefix(child, "[") {
+		return parent + child
+	}
+
+	return parent + "." + child
+}
+
+// Error returns the error message prefixed with the field name
+func (e *fieldError) Error() string {
+	if e.field == "" {
+		return e.err.Error()
+	}
+
+	return fmt.Sprintf(errMsgField, e.field, e.err)
+}
+
+// Unwrap returns the underlying assertion error
+func (e *fieldError) Unwrap() error {
+	return e.err
+}
+
+// Chain holds the common state of fluent assertions over a named value. Once
+// an assertion of the chain fails, subsequent assertions are skipped. Packages
+// extending Assertion embed it to build their own fluent chains
+type Chain struct {
+	a      *Assertion
+	name   string
+	failed bool
+}
+
+// NewChain returns a fluent assertion chain whose errors are added to a given
+// Assertion prefixed by a given field name
+func NewChain(a *Assertion, name string) Chain {
+	return Chain{a: a, name: name}
+}
+
+// Check runs a given assertion on a scratch Assertion unless the chain has
+// already failed or the chain Assertion reached its errors limit, and moves its
+// errors to the chain Assertion prefixed by the field name. It returns true if
+// the chain has not failed
+func (c *Chain) Check(fn func(a *Assertion) bool) bool {
+	if c.a.done() {
+		c.failed = true
+	}
+
+	if c.failed {
+		return false
+	}
+
+	scratch := New()
+	if fn(&scratch) {
+		return true
+	}
+
+	c.failed = true
+	c.a.addFieldErrors(c.name, scratch.errors)
+	return false
+}
+
+// Valid returns true if no assertion of the chain has failed
+func (c *Chain) Valid() bool {
+	return !c.failed
+}
+
+// Value is a fluent assertion chain over a named value of any comparable type,
+// created with Assertion.That
+type Value struct {
+	Chain
+	value interface{}
+}
+
+// That returns a fluent assertion chain over a given value identified by name,
+// e.g. a.That("age", age).GreaterThan(0).LowerThan(150). The chain stops on the
+// first failure and its errors are prefixed by the given name. Its arguments are
+// not type checked at compile time, see ThatOrdered
+func (a *Assertion) That(name string, value interface{}) *Value {
+	return &Value{Chain: NewChain(a, name), value: value}
+}
+
+// Nil asserts that the value is nil
+func (v *Value) Nil(msgArgs ...interface{}) *Value {
+	v.Check(func(a *Assertion) bool { return a.Nil(append([]interface{}{v.value}, msgArgs...)...) })
+	return v
+}
+
+// Equal asserts that the value is equal to other value
+func (v *Value) Equal(other interface{}, msgArgs ...interface{}) *Value {
+	v.Check(func(a *Assertion) bool { return a.Equal(append([]interface{}{v.value, other}, msgArgs...)...) })
+	return v
+}
+
+// GreaterThan asserts that the value is greater than other value
+func (v *Value) GreaterThan(other interface{}, msgArgs ...interface{}) *Value {
+	v.Check(func(a *Assertion) bool { return a.GreaterThan(append([]interface{}{v.value, other}, msgArgs...)...) })
+	return v
+}
+
+// GreaterThanOrEqual asserts that the value is greater than or equal to other value
+func (v *Value) GreaterThanOrEqual(other interface{}, msgArgs ...interface{}) *Value {
+	v.Check(func(a *Assertion) bool {
+		return a.GreaterThanOrEqual(append([]interface{}{v.value, other}, msgArgs...)...)
+	})
+	return v
+}
+
+// LowerThan asserts that the value is lower than other value
+func (v *Value) LowerThan(other interface{}, msgArgs ...interface{}) *Value {
+	v.Check(func(a *Assertion) bool { return a.LowerThan(append([]interface{}{v.value, other}, msgArgs...)...) })
+	return v
+}
+
+// LowerThanOrEqual asserts that the value is lower than or equal to other value
+func (v *Value) LowerThanOrEqual(other interface{}, msgArgs ...interface{}) *Value {
+	v.Check(func(a *Assertion) bool {
+		return a.LowerThanOrEqual(append([]interface{}{v.value, other}, msgArgs...)...)
+	})
+	return v
+}
+
+// Between asserts that the value is between a lower and upper limit (including both)
+func (v *Value) Between(lower, upper interface{}, msgArgs ...interface{}) *Value {
+	v.Check(func(a *Assertion) bool {
+		return a.Between(append([]interface{}{v.value, lower, upper}, msgArgs...)...)
+	})
+	return v
+}
+
+// BetweenExclude asserts that the value is between a lower and upper limit
+// (excluding both)
+func (v *Value) BetweenExclude(lower, upper interface{}, msgArgs ...interface{}) *Value {
+	v.Check(func(a *Assertion) bool {
+		return a.BetweenExclude(append([]interface{}{v.value, lower, upper}, msgArgs...)...)
+	})
+	return v
+}
+
+// HasKey asserts that the value is a map with the given key
+func (v *Value) HasKey(key interface{}, msgArgs ...interface{}) *Value {
+	v.Check(func(a *Assertion) bool { return a.HasKey(v.value, key, msgArgs...) })
+	return v
+}
+
+// HasKeys asserts that the value is a map with all the given keys
+func (v *Value) HasKeys(keys ...interface{}) *Value {
+	v.Check(func(a *Assertion) bool { return a.HasKeys(v.value, keys...) })
+	return v
+}
+
+// HasOnlyKeys asserts that the value is a map without keys other than the given ones
+func (v *Value) HasOnlyKeys(keys interface{}, msgArgs ...interface{}) *Value {
+	v.Check(func(a *Assertion) bool { return a.HasOnlyKeys(v.value, keys, msgArgs...) })
+	return v
+}
+
+// Rule asserts that the value satisfies the rule registered under a given name
+func (v *Value) Rule(name string, params ...interface{}) *Value {
+	v.Check(func(a *Assertion) bool { return a.Rule(name, v.value, params) })
+	return v
+}
+
+// OrderedValue is a fluent assertion chain over a named value of an ordered
+// type, created with ThatOrdered. Its arguments must be of the value type
+type OrderedValue[T cmp.Ordered] struct {
+	Chain
+	value T
+}
+
+// ThatOrdered returns a fluent assertion chain over a given value identified by
+// name, e.g. ThatOrdered(&a, "age", age).GreaterThan(0).LowerThan(150), where a
+// mistake like Between(1) or GreaterThan("0") does not compile. The chain stops
+// on the first failure and its errors are prefixed by the given name
+func ThatOrdered[T cmp.Ordered](a *Assertion, name string, value T) *OrderedValue[T] {
+	return &OrderedValue[T]{Chain: NewChain(a, name), value: value}
+}
+
+// Equal asserts that the value is equal to other value
+func (v *OrderedValue[T]) Equal(other T, msgArgs ...interface{}) *OrderedValue[T] {
+	v.Check(func(a *Assertion) bool { return Equal(a, v.value, other, msgArgs...) })
+	return v
+}
+
+// GreaterThan asserts that the value is greater than other value
+func (v *OrderedValue[T]) GreaterThan(other T, msgArgs ...interface{}) *OrderedValue[T] {
+	v.Check(func(a *Assertion) bool { return GreaterThan(a, v.value, other, msgArgs...) })
+	return v
+}
+
+// GreaterThanOrEqual asserts that the value is greater than or equal to other value
+func (v *OrderedValue[T]) GreaterThanOrEqual(other T, msgArgs ...interface{}) *OrderedValue[T] {
+	v.Check(func(a *Assertion) bool { return GreaterThanOrEqual(a, v.value, other, msgArgs...) })
+	return v
+}
+
+// LowerThan asserts that the value is lower than other value
+func (v *OrderedValue[T]) LowerThan(other T, msgArgs ...interface{}) *OrderedValue[T] {
+	v.Check(func(a *Assertion) bool { return LowerThan(a, v.value, other, msgArgs...) })
+	return v
+}
+
+// LowerThanOrEqual asserts that the value is lower than or equal to other value
+func (v *OrderedValue[T]) LowerThanOrEqual(other T, msgArgs ...interface{}) *OrderedValue[T] {
+	v.Check(func(a *Assertion) bool { return LowerThanOrEqual(a, v.value, other, msgArgs...) })
+	return v
+}
+
+// Between asserts that the value is between a lower and upper limit (including both)
+func (v *OrderedValue[T]) Between(lower, upper T, msgArgs ...interface{}) *OrderedValue[T] {
+	v.Check(func(a *Assertion) bool { return Between(a, v.value, lower, upper, msgArgs...) })
+	return v
+}
+
+// BetweenExclude asserts that the value is between a lower and upper limit
+// (excluding both)
+func (v *OrderedValue[T]) BetweenExclude(lower, upper T, msgArgs ...interface{}) *OrderedValue[T] {
+	v.Check(func(a *Assertion) bool { return BetweenExclude(a, v.value, lower, upper, msgArgs...) })
+	return v
+}
+
+// OneOf asserts that the value is equal to some of the given options
+func (v *OrderedValue[T]) OneOf(options []T, msgArgs ...interface{}) *OrderedValue[T] {
+	v.Check(func(a *Assertion) bool { return OneOf(a, v.value, options, msgArgs...) })
+	return v
+}
+
+// StringValue is a fluent assertion chain over a named string, created with
+// Assertion.ThatString
+type StringValue struct {
+	Chain
+	value string
+}
+
+// ThatString returns a fluent assertion chain over a given string identified by
+// name, e.g. a.ThatString("email", s).NotEmpty().Email(). The chain stops on the
+// first failure and its errors are prefixed by the given name
+func (a *Assertion) ThatString(name string, value string) *StringValue {
+	return &StringValue{Chain: NewChain(a, name), value: value}
+}
+
+// is runs a given string assertion method on the chain value
+func (s *StringValue) is(fn func(a *Assertion, value string, msgArgs ...interface{}) bool, msgArgs ...interface{}) *StringValue {
+	s.Check(func(a *Assertion) bool { return fn(a, s.value, msgArgs...) })
+	return s
+}
+
+// NotEmpty asserts that the string is not empty
+func (s *StringValue) NotEmpty(msgArgs ...interface{}) *StringValue {
+	s.Check(func(a *Assertion) bool {
+		if s.value != "" {
+			return true
+		}
+
+		a.fail("required", s.value, nil, msgArgs...)
+		return false
+	})
+	return s
+}
+
+// Equal asserts that the string is equal to other string
+func (s *StringValue) Equal(other string, msgArgs ...interface{}) *StringValue {
+	s.Check(func(a *Assertion) bool { return a.EqualString(s.value, other, CompareExact, msgArgs...) })
+	return s
+}
+
+// EqualMode asserts that the string is equal to other string when compared with
+// the given comparison mode
+func (s *StringValue) EqualMode(other string, mode Comparison, msgArgs ...interface{}) *StringValue {
+	s.Check(func(a *Assertion) bool { return a.EqualString(s.value, other, mode, msgArgs...) })
+	return s
+}
+
+// StartsWith asserts that the string starts with the given needle
+func (s *StringValue) StartsWith(needle string, msgArgs ...interface{}) *StringValue {
+	s.Check(func(a *Assertion) bool { return a.StartsWith(s.value, needle, msgArgs...) })
+	return s
+}
+
+// EndsWith asserts that the string ends with the given needle
+func (s *StringValue) EndsWith(needle string, msgArgs ...interface{}) *StringValue {
+	s.Check(func(a *Assertion) bool { return a.EndsWith(s.value, needle, msgArgs...) })
+	return s
+}
+
+// Contains asserts that the string contains the given needle
+func (s *StringValue) Contains(needle string, msgArgs ...interface{}) *StringValue {
+	s.Check(func(a *Assertion) bool { return a.Contains(s.value, needle, msgArgs...) })
+	return s
+}
+
+// StartsWithInsensitive asserts that the string starts with the given needle
+// with insensitive case
+func (s *StringValue) StartsWithInsensitive(needle string, msgArgs ...interface{}) *StringValue {
+	s.Check(func(a *Assertion) bool { return a.StartsWithInsensitive(s.value, needle, msgArgs...) })
+	return s
+}
+
+// EndsWithInsensitive asserts that the string ends with the given needle with
+// insensitive case
+func (s *StringValue) EndsWithInsensitive(needle string, msgArgs ...interface{}) *StringValue {
+	s.Check(func(a *Assertion) bool { return a.EndsWithInsensitive(s.value, needle, msgArgs...) })
+	return s
+}
+
+// ContainsInsensitive asserts that the string contains the given needle with
+// insensitive case
+func (s *StringValue) ContainsInsensitive(needle string, msgArgs ...interface{}) *StringValue {
+	s.Check(func(a *Assertion) bool { return a.ContainsInsensitive(s.value, needle, msgArgs...) })
+	return s
+}
+
+// Matches asserts that the string matches the given regular expression pattern
+func (s *StringValue) Matches(pattern interface{}, msgArgs ...interface{}) *StringValue {
+	s.Check(func(a *Assertion) bool { return a.Matches(s.value, pattern, msgArgs...) })
+	return s
+}
+
+// NotMatches asserts that the string does not match the given regular expression pattern
+func (s *StringValue) NotMatches(pattern interface{}, msgArgs ...interface{}) *StringValue {
+	s.Check(func(a *Assertion) bool { return a.NotMatches(s.value, pattern, msgArgs...) })
+	return s
+}
+
+// Rule asserts that the string satisfies the rule registered under a given name
+func (s *StringValue) Rule(name string, params ...interface{}) *StringValue {
+	s.Check(func(a *Assertion) bool { return a.Rule(name, s.value, params) })
+	return s
+}
+
+// Email asserts that the string is a valid email
+func (s *StringValue) Email(msgArgs ...interface{}) *StringValue {
+	return s.is((*Assertion).Email, msgArgs...)
+}
+
+// Phone asserts that the string is a valid e164 phone number
+func (s *StringValue) Phone(msgArgs ...interface{}) *StringValue {
+	return s.is((*Assertion).Phone, msgArgs...)
+}
+
+// Ipv4 asserts that the string is a valid ipv4
+func (s *StringValue) Ipv4(msgArgs ...interface{}) *StringValue {
+	return s.is((*Assertion).Ipv4, msgArgs...)
+}
+
+// Alfanum asserts that the string only contains alfa-numeric runes
+func (s *StringValue) Alfanum(msgArgs ...interface{}) *StringValue {
+	return s.is((*Assertion).Alfanum, msgArgs...)
+}
+
+// Digits asserts that the string only contains digit runes
+func (s *StringValue) Digits(msgArgs ...interface{}) *StringValue {
+	return s.is((*Assertion).Digits, msgArgs...)
+}
+
+// Letters asserts that the string only contains letter runes
+func (s *StringValue) Letters(msgArgs ...interface{}) *StringValue {
+	return s.is((*Assertion).Letters, msgArgs...)
+}
+
+// ASCII asserts that the string only contains ASCII runes
+func (s *StringValue) ASCII(msgArgs ...interface{}) *StringValue {
+	return s.is((*Assertion).ASCII, msgArgs...)
+}
+
+// Lowercase asserts that the string has no upper or title case runes
+func (s *StringValue) Lowercase(msgArgs ...interface{}) *StringValue {
+	return s.is((*Assertion).Lowercase, msgArgs...)
+}
+
+// Uppercase asserts that the string has no lower or title case runes
+func (s *StringValue) Uppercase(msgArgs ...interface{}) *StringValue {
+	return s.is((*Assertion).Uppercase, msgArgs...)
+}
+
+// Slug asserts that the string is a valid slug
+func (s *StringValue) Slug(msgArgs ...interface{}) *StringValue {
+	return s.is((*Assertion).Slug, msgArgs...)
+}
+
+// Hexadecimal asserts that the string only contains hexadecimal digits
+func (s *StringValue) Hexadecimal(msgArgs ...interface{}) *StringValue {
+	return s.is((*Assertion).Hexadecimal, msgArgs...)
+}
+
+// Boolean asserts that the string can be parsed as a boolean
+func (s *StringValue) Boolean(msgArgs ...interface{}) *StringValue {
+	return s.is((*Assertion).Boolean, msgArgs...)
+}
+
+// Integer asserts that the string can be parsed as an integer
+func (s *StringValue) Integer(msgArgs ...interface{}) *StringValue {
+	return s.is((*Assertion).Integer, msgArgs...)
+}
+
+// Unsigned asserts that the string can be parsed as an unsigned integer
+func (s *StringValue) Unsigned(msgArgs ...interface{}) *StringValue {
+	return s.is((*Assertion).Unsigned, msgArgs...)
+}
+
+// Float asserts that the string can be parsed as a float
+func (s *StringValue) Float(msgArgs ...interface{}) *StringValue {
+	return s.is((*Assertion).Float, msgArgs...)
+}
+
+// Base64 asserts that the string is a valid base64 encoded value
+func (s *StringValue) Base64(msgArgs ...interface{}) *StringValue {
+	return s.is((*Assertion).Base64, msgArgs...)
+}
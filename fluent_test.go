@@ -0,0 +1,82 @@
+package assertion
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAssertion_That(t *testing.T) {
+	a := New()
+
+	assert.True(t, a.That("age", 30).GreaterThan(0).LowerThan(150).Between(18, 65).Valid())
+	assert.False(t, a.HasErrors())
+
+	v := a.That("age", -1).GreaterThan(0).LowerThan(-5).Equal(7)
+	assert.False(t, v.Valid())
+	assert.Equal(t, 1, a.CountErrors())
+	assert.EqualError(t, a.ErrorAt(0), "age: -1 is not greater than 0")
+}
+
+func TestAssertion_That_TypeMismatch(t *testing.T) {
+	a := New()
+
+	assert.False(t, a.That("age", 30).GreaterThan("0").Valid())
+	assert.EqualError(t, a.ErrorAt(0), "age: 30 and 0 are not of the same type")
+}
+
+func TestThatOrdered(t *testing.T) {
+	a := New()
+
+	assert.True(t, ThatOrdered(&a, "age", 30).GreaterThan(0).LowerThan(150).Between(18, 65).Valid())
+	assert.True(t, ThatOrdered(&a, "lang", "es").OneOf([]string{"en", "es"}).Valid())
+	assert.False(t, a.HasErrors())
+
+	v := ThatOrdered(&a, "price", 9.5).GreaterThanOrEqual(10, "too cheap").LowerThan(5)
+	assert.False(t, v.Valid())
+	assert.False(t, ThatOrdered(&a, "age", uint8(200)).BetweenExclude(0, 150).Valid())
+	assert.Equal(t, 2, a.CountErrors())
+	assert.EqualError(t, a.ErrorAt(0), "price: too cheap")
+	assert.EqualError(t, a.ErrorAt(1), "age: 200 is not between 0 and 150 both excluded")
+}
+
+func TestAssertion_That_CustomMessage(t *testing.T) {
+	a := New()
+
+	a.That("age", 200).Between(0, 150, "age out of range")
+	a.That("", 200).LowerThan(100, "%s is too high", "age")
+
+	assert.EqualError(t, a.ErrorAt(0), "age: age out of range")
+	assert.EqualError(t, a.ErrorAt(1), "age is too high")
+}
+
+func TestAssertion_That_Maps(t *testing.T) {
+	a := New()
+	cfg := map[string]string{"host": "localhost", "port": "80", "debug": "1"}
+
+	assert.True(t, a.That("cfg", cfg).HasKey("host").HasKeys("host", "port").Valid())
+	assert.False(t, a.That("cfg", cfg).HasOnlyKeys([]string{"host", "port"}).Valid())
+	assert.EqualError(t, a.ErrorAt(0), "cfg: map[debug:1 host:localhost port:80] has unexpected keys [debug]")
+}
+
+func TestAssertion_ThatString(t *testing.T) {
+	a := New()
+
+	assert.True(t, a.ThatString("email", "test@mail.com").NotEmpty().Email().EndsWith(".com").Valid())
+	assert.False(t, a.HasErrors())
+
+	assert.False(t, a.ThatString("email", "").NotEmpty().Email().Valid())
+	assert.False(t, a.ThatString("name", "Bob").Lowercase().Letters().Valid())
+
+	assert.Equal(t, 2, a.CountErrors())
+	assert.EqualError(t, a.ErrorAt(0), "email: value is empty")
+	assert.EqualError(t, a.ErrorAt(1), "name: Bob is not lowercase")
+}
+
+func TestAssertion_ThatString_Unwrap(t *testing.T) {
+	a := New()
+
+	a.ThatString("code", "abc").Matches(`(`)
+
+	assert.True(t, errors.Is(a.ErrorAt(0), ErrInvalidPattern))
+}
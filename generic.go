@@ -0,0 +1,131 @@
+package assertion
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// Equal returns true if a given value is equal to other value of the same type
+func Equal[T comparable](a *Assertion, value, other T, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		if value == other {
+			return true
+		}
+
+		a.fail("equal", value, map[string]interface{}{"Other": other}, msgArgs...)
+		return false
+	})
+}
+
+// GreaterThan returns true if a given value is greater than other value
+func GreaterThan[T cmp.Ordered](a *Assertion, value, other T, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		return ordered(a, cmpOpGreater, value, other, msgArgs...)
+	})
+}
+
+// GreaterThanOrEqual returns true if a given value is greater than or equal to other value
+func GreaterThanOrEqual[T cmp.Ordered](a *Assertion, value, other T, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		return ordered(a, cmpOpGreaterEqual, value, other, msgArgs...)
+	})
+}
+
+// LowerThan returns true if a given value is lower than other value
+func LowerThan[T cmp.Ordered](a *Assertion, value, other T, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		return ordered(a, cmpOpLower, value, other, msgArgs...)
+	})
+}
+
+// LowerThanOrEqual returns true if a given value is lower than or equal to other value
+func LowerThanOrEqual[T cmp.Ordered](a *Assertion, value, other T, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		return ordered(a, cmpOpLowerEqual, value, other, msgArgs...)
+	})
+}
+
+// Between returns true if a given value is between a lower and upper limit
+// values (including both)
+func Between[T cmp.Ordered](a *Assertion, value, lower, upper T, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		if value >= lower && value <= upper {
+			return true
+		}
+
+		a.fail("between", value, map[string]interface{}{"Min": lower, "Max": upper}, msgArgs...)
+		return false
+	})
+}
+
+// BetweenExclude returns true if a given value is between a lower and upper
+// limit values (excluding both)
+func BetweenExclude[T cmp.Ordered](a *Assertion, value, lower, upper T, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		if value > lower && value < upper {
+			return true
+		}
+
+		a.fail("betweenexclude", value, map[string]interface{}{"Min": lower, "Max": upper}, msgArgs...)
+		return false
+	})
+}
+
+// OneOf returns true if a given value is equal to some of the given options
+func OneOf[T comparable](a *Assertion, value T, options []T, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		for _, o := range options {
+			if value == o {
+				return true
+			}
+		}
+
+		a.fail("oneof", value, map[string]interface{}{"Options": options}, msgArgs...)
+		return false
+	})
+}
+
+// Each returns true if the given assertion function returns true for every
+// element of a given slice. All the elements are checked, and the errors of each
+// one are prefixed by its index, e.g. "[2]: 0 is not greater than 0"
+func Each[T any](a *Assertion, values []T, fn func(a *Assertion, value T) bool) bool {
+	return a.eval(func() bool {
+		ok := true
+		for i, v := range values {
+			if a.done() {
+				return false
+			}
+
+			scratch := New()
+			if !fn(&scratch, v) {
+				ok = false
+				a.addFieldErrors(fmt.Sprintf("[%d]", i), scratch.errors)
+			}
+		}
+
+		return ok
+	})
+}
+
+// ordered returns true if a given value and other value satisfy the compare
+// operation determined by the operator. Otherwise it adds the same error as the
+// reflection based compare
+func ordered[T cmp.Ordered](a *Assertion, op int, value, other T, msgArgs ...interface{}) bool {
+	var ok bool
+	switch op {
+	case cmpOpGreater:
+		ok = value > other
+	case cmpOpGreaterEqual:
+		ok = value >= other
+	case cmpOpLower:
+		ok = value < other
+	case cmpOpLowerEqual:
+		ok = value <= other
+	}
+
+	if !ok {
+		a.fail(ruleByOp[op], value, map[string]interface{}{"Other": other}, msgArgs...)
+	}
+
+	return ok
+}
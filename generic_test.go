@@ -0,0 +1,67 @@
+package assertion
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type age int
+
+func TestGeneric_Compare(t *testing.T) {
+	a := New()
+
+	assert.True(t, Equal(&a, age(1), 1))
+	assert.True(t, GreaterThan(&a, age(30), 18))
+	assert.True(t, GreaterThanOrEqual(&a, 1.5, 1.5))
+	assert.True(t, LowerThan(&a, "a", "b"))
+	assert.True(t, LowerThanOrEqual(&a, uint8(3), 3))
+	assert.False(t, a.HasErrors())
+
+	assert.False(t, Equal(&a, "a", "b"))
+	assert.False(t, GreaterThan(&a, age(1), 1))
+	assert.False(t, GreaterThanOrEqual(&a, 1.4, 1.5))
+	assert.False(t, LowerThan(&a, "b", "a", "custom error"))
+	assert.False(t, LowerThanOrEqual(&a, 4, 3, "%d is too high", 4))
+
+	assert.Equal(t, 5, a.CountErrors())
+	assert.EqualError(t, a.ErrorAt(0), "a is not equal b")
+	assert.EqualError(t, a.ErrorAt(1), "1 is not greater than 1")
+	assert.EqualError(t, a.ErrorAt(2), "1.4 is not greater than or equal 1.5")
+	assert.EqualError(t, a.ErrorAt(3), "custom error")
+	assert.EqualError(t, a.ErrorAt(4), "4 is too high")
+}
+
+func TestGeneric_Between(t *testing.T) {
+	a := New()
+
+	assert.True(t, Between(&a, age(18), 18, 65))
+	assert.True(t, BetweenExclude(&a, 0.5, 0, 1))
+	assert.False(t, a.HasErrors())
+
+	assert.False(t, Between(&a, age(66), 18, 65))
+	assert.False(t, BetweenExclude(&a, 1.0, 0, 1))
+
+	assert.EqualError(t, a.ErrorAt(0), "66 is not between 18 and 65")
+	assert.EqualError(t, a.ErrorAt(1), "1 is not between 0 and 1 both excluded")
+}
+
+func TestGeneric_OneOf(t *testing.T) {
+	a := New()
+
+	assert.True(t, OneOf(&a, "es", []string{"en", "es"}))
+	assert.False(t, OneOf(&a, "de", []string{"en", "es"}))
+	assert.EqualError(t, a.ErrorAt(0), "de is not one of [en es]")
+}
+
+func TestGeneric_Each(t *testing.T) {
+	a := New()
+	positive := func(a *Assertion, v int) bool { return GreaterThan(a, v, 0) }
+
+	assert.True(t, Each(&a, []int{1, 2, 3}, positive))
+	assert.False(t, a.HasErrors())
+
+	assert.False(t, Each(&a, []int{1, 0, 3, -1}, positive))
+	assert.Equal(t, 2, a.CountErrors())
+	assert.EqualError(t, a.ErrorAt(0), "[1]: 0 is not greater than 0")
+	assert.EqualError(t, a.ErrorAt(1), "[3]: -1 is not greater than 0")
+}
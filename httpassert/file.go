@@ -0,0 +1,284 @@
+package httpassert
+
+import (
+	"archive/zip"
+	"bytes"
+	"github.com/sangarbe/assertion"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// sniffLen is the number of bytes read to detect the MIME type of a file
+const sniffLen = 512
+
+const (
+	mimeOLE  = "application/x-ole-storage"
+	mimeZIP  = "application/zip"
+	mimeDOCX = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	mimeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	mimePPTX = "application/vnd.openxmlformats-officedocument.presentationml.presentation"
+)
+
+// magicOLE is the signature of OLE2 compound files, the legacy Office format
+var magicOLE = []byte("\xD0\xCF\x11\xE0\xA1\xB1\x1A\xE1")
+
+// officeDirs are the MIME types of Office Open XML documents by the directory
+// holding their main part
+var officeDirs = map[string]string{
+	"word/": mimeDOCX,
+	"xl/":   mimeXLSX,
+	"ppt/":  mimePPTX,
+}
+
+func init() {
+	assertion.RegisterMessages("en", map[string]string{
+		"httpassert.file":            `cannot be read: {{.Error}}`,
+		"httpassert.maxfilesize":     `size {{.Value}} exceeds {{.Max}} bytes`,
+		"httpassert.mimetype":        `{{.Value}} is not one of {{.Types}}`,
+		"httpassert.extension":       `{{.Value}} has not one of the extensions {{.Extensions}}`,
+		"httpassert.image":           `is not a valid image: {{.Error}}`,
+		"httpassert.imagedimensions": `{{.Value}} is not between {{.Min}} and {{.Max}}`,
+	})
+	assertion.RegisterMessages("es", map[string]string{
+		"httpassert.file":            `no se puede leer: {{.Error}}`,
+		"httpassert.maxfilesize":     `el tamaño {{.Value}} supera {{.Max}} bytes`,
+		"httpassert.mimetype":        `{{.Value}} no es uno de {{.Types}}`,
+		"httpassert.extension":       `{{.Value}} no tiene una de las extensiones {{.Extensions}}`,
+		"httpassert.image":           `no es una imagen válida: {{.Error}}`,
+		"httpassert.imagedimensions": `{{.Value}} no está entre {{.Min}} y {{.Max}}`,
+	})
+	assertion.RegisterMessages("fr", map[string]string{
+		"httpassert.file":            `ne peut pas être lu : {{.Error}}`,
+		"httpassert.maxfilesize":     `la taille {{.Value}} dépasse {{.Max}} octets`,
+		"httpassert.mimetype":        `{{.Value}} n'est pas l'un de {{.Types}}`,
+		"httpassert.extension":       `{{.Value}} n'a pas l'une des extensions {{.Extensions}}`,
+		"httpassert.image":           `n'est pas une image valide : {{.Error}}`,
+		"httpassert.imagedimensions": `{{.Value}} n'est pas entre {{.Min}} et {{.Max}}`,
+	})
+	assertion.RegisterMessages("de", map[string]string{
+		"httpassert.file":            `kann nicht gelesen werden: {{.Error}}`,
+		"httpassert.maxfilesize":     `die Größe {{.Value}} überschreitet {{.Max}} Bytes`,
+		"httpassert.mimetype":        `{{.Value}} ist keiner von {{.Types}}`,
+		"httpassert.extension":       `{{.Value}} hat keine der Endungen {{.Extensions}}`,
+		"httpassert.image":           `ist kein gültiges Bild: {{.Error}}`,
+		"httpassert.imagedimensions": `{{.Value}} liegt nicht zwischen {{.Min}} und {{.Max}}`,
+	})
+	assertion.RegisterMessages("pt", map[string]string{
+		"httpassert.file":            `não pode ser lido: {{.Error}}`,
+		"httpassert.maxfilesize":     `o tamanho {{.Value}} excede {{.Max}} bytes`,
+		"httpassert.mimetype":        `{{.Value}} não é um de {{.Types}}`,
+		"httpassert.extension":       `{{.Value}} não tem uma das extensões {{.Extensions}}`,
+		"httpassert.image":           `não é uma imagem válida: {{.Error}}`,
+		"httpassert.imagedimensions": `{{.Value}} não está entre {{.Min}} e {{.Max}}`,
+	})
+}
+
+// File is a fluent assertion chain over an uploaded file identified by name.
+// Missing files are only checked by Required, any other assertion is skipped.
+// The chain stops on the first failure and its errors are prefixed by the name
+type File struct {
+	assertion.Chain
+	header *multipart.FileHeader
+}
+
+// File returns a fluent assertion chain over the first file of a given field of
+// the multipart form of the request
+func (r *Request) File(name string) *File {
+	r.parseForm()
+
+	var fh *multipart.FileHeader
+	if r.req.MultipartForm != nil && len(r.req.MultipartForm.File[name]) > 0 {
+		fh = r.req.MultipartForm.File[name][0]
+	}
+
+	return r.FileHeader(name, fh)
+}
+
+// FileHeader returns a fluent assertion chain over a given file identified by
+// name, e.g. one of the several files of a form field
+func (r *Request) FileHeader(name string, fh *multipart.FileHeader) *File {
+	return &File{Chain: assertion.NewChain(r.Assertion, name), header: fh}
+}
+
+// Header returns the file header, nil if the file is missing
+func (f *File) Header() *multipart.FileHeader {
+	return f.header
+}
+
+// Required asserts that the file is present
+func (f *File) Required(msgArgs ...interface{}) *File {
+	if f.header != nil {
+		return f
+	}
+
+	return f.fail(assertion.NewError("required", nil, nil, msgArgs...))
+}
+
+// MaxFileSize asserts that the file size is at most n bytes
+func (f *File) MaxFileSize(n int64, msgArgs ...interface{}) *File {
+	if f.skip() || f.header.Size <= n {
+		return f
+	}
+
+	return f.fail(assertion.NewError("httpassert.maxfilesize", f.header.Size, map[string]interface{}{"Max": n}, msgArgs...))
+}
+
+// Extension asserts that the file name has one of the given extensions, e.g.
+// Extension([]string{".jpg", ".png"}), case insensitively
+func (f *File) Extension(exts []string, msgArgs ...interface{}) *File {
+	if f.skip() {
+		return f
+	}
+
+	ext := filepath.Ext(f.header.Filename)
+	for _, e := range exts {
+		if strings.EqualFold(ext, e) {
+			return f
+		}
+	}
+
+	return f.fail(assertion.NewError("httpassert.extension", f.header.Filename, map[string]interface{}{"Extensions": exts}, msgArgs...))
+}
+
+// MIMEType asserts that the MIME type of the file content is one of the given
+// types, e.g. MIMEType([]string{"image/*", "application/pdf"}). The type is
+// detected from the content as http.DetectContentType does, telling apart Office
+// documents from other ZIP and OLE2 files. The declared Content-Type is ignored
+func (f *File) MIMEType(allowed []string, msgArgs ...interface{}) *File {
+	if f.skip() {
+		return f
+	}
+
+	mimeType, err := f.detect()
+	if err != nil {
+		return f.fail(assertion.NewError("httpassert.file", nil, map[string]interface{}{"Error": err}))
+	}
+
+	for _, t := range allowed {
+		if matchMIME(mimeType, t) {
+			return f
+		}
+	}
+
+	return f.fail(assertion.NewError("httpassert.mimetype", mimeType, map[string]interface{}{"Types": allowed}, msgArgs...))
+}
+
+// ImageDimensions asserts that the file is a GIF, JPEG or PNG image with a
+// width and height between the given limits (including both). Zero maximums
+// mean no limit
+func (f *File) ImageDimensions(minW, minH, maxW, maxH int, msgArgs ...interface{}) *File {
+	if f.skip() {
+		return f
+	}
+
+	file, err := f.header.Open()
+	if err != nil {
+		return f.fail(assertion.NewError("httpassert.file", nil, map[string]interface{}{"Error": err}))
+	}
+	defer file.Close()
+
+	cfg, _, err := image.DecodeConfig(file)
+	if err != nil {
+		return f.fail(assertion.NewError("httpassert.image", nil, map[string]interface{}{"Error": err}))
+	}
+
+	if cfg.Width >= minW && cfg.Height >= minH && (maxW <= 0 || cfg.Width <= maxW) && (maxH <= 0 || cfg.Height <= maxH) {
+		return f
+	}
+
+	return f.fail(assertion.NewError("httpassert.imagedimensions", dimensions{cfg.Width, cfg.Height}, map[string]interface{}{
+		"Min":    dimensions{minW, minH},
+		"Max":    dimensions{maxW, maxH},
+		"Width":  cfg.Width,
+		"Height": cfg.Height,
+	}, msgArgs...))
+}
+
+// skip returns true if the file is missing or the chain has already failed
+func (f *File) skip() bool {
+	return f.header == nil || !f.Valid()
+}
+
+// fail records a given error of the file and stops the chain
+func (f *File) fail(err *assertion.Error) *File {
+	f.Check(func(a *assertion.Assertion) bool {
+		a.AddError(err)
+		return false
+	})
+	return f
+}
+
+// detect returns the MIME type of the file content, without parameters
+func (f *File) detect() (string, error) {
+	file, err := f.header.Open()
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+	head = head[:n]
+
+	if bytes.HasPrefix(head, magicOLE) {
+		return mimeOLE, nil
+	}
+
+	mimeType, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	if mimeType == mimeZIP {
+		return officeType(file, f.header.Size), nil
+	}
+
+	return mimeType, nil
+}
+
+// officeType returns the MIME type of an Office Open XML document by the main
+// directory of a given ZIP file, or the ZIP MIME type if it is not a document
+func officeType(file io.ReaderAt, size int64) string {
+	zr, err := zip.NewReader(file, size)
+	if err != nil {
+		return mimeZIP
+	}
+
+	for _, zf := range zr.File {
+		for dir, mimeType := range officeDirs {
+			if strings.HasPrefix(zf.Name, dir) {
+				return mimeType
+			}
+		}
+	}
+
+	return mimeZIP
+}
+
+// matchMIME returns true if a given MIME type matches an allowed type case
+// insensitively. The allowed type may be a wildcard for a whole top-level type,
+// e.g. "image/*"
+func matchMIME(mimeType, allowed string) bool {
+	mimeType, allowed = strings.ToLower(mimeType), strings.ToLower(allowed)
+	if prefix, ok := strings.CutSuffix(allowed, "/*"); ok {
+		return strings.HasPrefix(mimeType, prefix+"/")
+	}
+
+	return mimeType == allowed
+}
+
+// dimensions are the width and height of an image
+type dimensions [2]int
+
+// String returns the dimensions as width x height, e.g. 640x480
+func (d dimensions) String() string {
+	return strconv.Itoa(d[0]) + "x" + strconv.Itoa(d[1])
+}
@@ -0,0 +1,105 @@
+package httpassert
+
+import (
+	"archive/zip"
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"image"
+	"image/png"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newMultipartRequest(t *testing.T, files map[string][2]string) *http.Request {
+	body := new(bytes.Buffer)
+	w := multipart.NewWriter(body)
+	for field, f := range files {
+		fw, err := w.CreateFormFile(field, f[0])
+		assert.NoError(t, err)
+		_, _ = fw.Write([]byte(f[1]))
+	}
+	assert.NoError(t, w.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/upload", body)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	return req
+}
+
+func newPNG(t *testing.T, width, height int) string {
+	buf := new(bytes.Buffer)
+	assert.NoError(t, png.Encode(buf, image.NewRGBA(image.Rect(0, 0, width, height))))
+	return buf.String()
+}
+
+func newZIP(t *testing.T, names ...string) string {
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+	for _, name := range names {
+		_, err := zw.Create(name)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, zw.Close())
+	return buf.String()
+}
+
+func TestFile_MIMEType(t *testing.T) {
+	ha := New(newMultipartRequest(t, map[string][2]string{
+		"avatar":   {"me.png", newPNG(t, 10, 10)},
+		"document": {"cv.pdf", "%PDF-1.7\n%..."},
+		"report":   {"report.docx", newZIP(t, "[Content_Types].xml", "word/document.xml")},
+		"archive":  {"files.zip", newZIP(t, "a.txt")},
+		"legacy":   {"old.doc", "\xD0\xCF\x11\xE0\xA1\xB1\x1A\xE1 ..."},
+		"fake":     {"fake.png", "plain text"},
+	}))
+
+	assert.True(t, ha.File("avatar").Required().MIMEType([]string{"image/*"}).Valid())
+	assert.True(t, ha.File("document").MIMEType([]string{"application/pdf"}).Valid())
+	assert.True(t, ha.File("report").MIMEType([]string{mimeDOCX}).Valid())
+	assert.True(t, ha.File("archive").MIMEType([]string{"application/zip"}).Valid())
+	assert.True(t, ha.File("legacy").MIMEType([]string{mimeOLE}).Valid())
+	assert.False(t, ha.File("fake").Extension([]string{".png"}).MIMEType([]string{"image/png"}).Valid())
+	assert.False(t, ha.File("missing").Required().MIMEType([]string{"image/png"}).Valid())
+	assert.True(t, ha.File("optional").MIMEType([]string{"image/png"}).Valid())
+	assert.True(t, ha.File("avatar").MIMEType([]string{"IMAGE/*"}).Valid())
+	assert.True(t, ha.File("document").MIMEType([]string{"Application/PDF"}).Valid())
+
+	assert.Equal(t, 2, ha.CountErrors())
+	assert.EqualError(t, ha.ErrorAt(0), "fake: text/plain is not one of [image/png]")
+	assert.EqualError(t, ha.ErrorAt(1), "missing: value is empty")
+}
+
+func TestFile_MaxFileSize(t *testing.T) {
+	ha := New(newMultipartRequest(t, map[string][2]string{"document": {"cv.pdf", "%PDF-1.7\n%..."}}))
+
+	assert.True(t, ha.File("document").MaxFileSize(16).Valid())
+	assert.False(t, ha.File("document").MaxFileSize(8).Valid())
+	assert.EqualError(t, ha.ErrorAt(0), "document: size 13 exceeds 8 bytes")
+}
+
+func TestFile_Extension(t *testing.T) {
+	ha := New(newMultipartRequest(t, map[string][2]string{"avatar": {"me.JPG", "..."}}))
+
+	assert.True(t, ha.File("avatar").Extension([]string{".jpg", ".jpeg"}).Valid())
+	assert.False(t, ha.File("avatar").Extension([]string{".png"}).Valid())
+	assert.False(t, ha.File("avatar").Extension([]string{".png"}, "must be a %s image", "PNG").Valid())
+	assert.False(t, ha.File("avatar").MIMEType([]string{"image/png"}, "must be a PNG image").Valid())
+	assert.EqualError(t, ha.ErrorAt(0), "avatar: me.JPG has not one of the extensions [.png]")
+	assert.EqualError(t, ha.ErrorAt(1), "avatar: must be a PNG image")
+	assert.EqualError(t, ha.ErrorAt(2), "avatar: must be a PNG image")
+}
+
+func TestFile_ImageDimensions(t *testing.T) {
+	ha := New(newMultipartRequest(t, map[string][2]string{
+		"avatar": {"me.png", newPNG(t, 64, 32)},
+		"fake":   {"fake.png", "plain text"},
+	}))
+
+	assert.True(t, ha.File("avatar").ImageDimensions(32, 32, 0, 0).Valid())
+	assert.False(t, ha.File("avatar").ImageDimensions(16, 16, 48, 48).Valid())
+	assert.False(t, ha.File("fake").ImageDimensions(16, 16, 48, 48).Valid())
+
+	assert.EqualError(t, ha.ErrorAt(0), "avatar: 64x32 is not between 16x16 and 48x48")
+	assert.EqualError(t, ha.ErrorAt(1), "fake: is not a valid image: image: unknown format")
+}
@@ -0,0 +1,272 @@
+// Package httpassert validates the query parameters, form values, headers, path
+// values and bodies of HTTP requests with the assertion rules, e.g.
+// ha.Query("page").Integer().Between(1, 1000), and writes the failures as
+// problem details responses.
+package httpassert
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/sangarbe/assertion"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// DefaultMaxBodySize is the maximum size in bytes of the bodies read by a
+// Request, unless changed with MaxBodySize
+const DefaultMaxBodySize = 1 << 20
+
+const (
+	fieldBody        = "body"
+	fieldContentType = "Content-Type"
+	fieldForm        = "form"
+)
+
+func init() {
+	assertion.RegisterMessages("en", map[string]string{
+		"httpassert.contenttype": `{{.Value}} is not one of {{.Types}}`,
+		"httpassert.maxbodysize": `size exceeds {{.Max}} bytes`,
+		"httpassert.json":        `invalid json: {{.Error}}`,
+		"httpassert.form":        `invalid form: {{.Error}}`,
+	})
+	assertion.RegisterMessages("es", map[string]string{
+		"httpassert.contenttype": `{{.Value}} no es uno de {{.Types}}`,
+		"httpassert.maxbodysize": `el tamaño supera {{.Max}} bytes`,
+		"httpassert.json":        `json no válido: {{.Error}}`,
+		"httpassert.form":        `formulario no válido: {{.Error}}`,
+	})
+	assertion.RegisterMessages("fr", map[string]string{
+		"httpassert.contenttype": `{{.Value}} n'est pas l'un de {{.Types}}`,
+		"httpassert.maxbodysize": `la taille dépasse {{.Max}} octets`,
+		"httpassert.json":        `json invalide : {{.Error}}`,
+		"httpassert.form":        `formulaire invalide : {{.Error}}`,
+	})
+	assertion.RegisterMessages("de", map[string]string{
+		"httpassert.contenttype": `{{.Value}} ist keiner von {{.Types}}`,
+		"httpassert.maxbodysize": `die Größe überschreitet {{.Max}} Bytes`,
+		"httpassert.json":        `ungültiges JSON: {{.Error}}`,
+		"httpassert.form":        `ungültiges Formular: {{.Error}}`,
+	})
+	assertion.RegisterMessages("pt", map[string]string{
+		"httpassert.contenttype": `{{.Value}} não é um de {{.Types}}`,
+		"httpassert.maxbodysize": `o tamanho excede {{.Max}} bytes`,
+		"httpassert.json":        `json inválido: {{.Error}}`,
+		"httpassert.form":        `formulário inválido: {{.Error}}`,
+	})
+}
+
+// Request is an Assertion over an HTTP request. Failures about the request
+// format, like an invalid body or content type, make it a bad request, while
+// failures of the values make it an unprocessable one, see Problem
+type Request struct {
+	*assertion.Assertion
+	req         *http.Request
+	query       url.Values
+	maxBodySize int64
+	malformed   bool
+}
+
+// New creates and returns a new Request over a given HTTP request, with an
+// Assertion configured with the given options
+func New(r *http.Request, opts ...assertion.Option) *Request {
+	a := assertion.New(opts...)
+	return &Request{Assertion: &a, req: r, maxBodySize: DefaultMaxBodySize}
+}
+
+// Query returns a fluent assertion chain over the first value of a given query
+// parameter
+func (r *Request) Query(name string) *Param {
+	if r.query == nil {
+		r.query = r.req.URL.Query()
+	}
+
+	values, ok := r.query[name]
+	return newParam(r, name, values, ok)
+}
+
+// Form returns a fluent assertion chain over the first value of a given form
+// field, either url encoded or multipart, or query parameter. Form bodies are
+// limited to the maximum body size, whether they declare their length or not
+func (r *Request) Form(name string) *Param {
+	r.parseForm()
+
+	values, ok := r.req.Form[name]
+	return newParam(r, name, values, ok)
+}
+
+// Header returns a fluent assertion chain over the first value of a given header
+func (r *Request) Header(name string) *Param {
+	values := r.req.Header.Values(name)
+	return newParam(r, name, values, len(values) > 0)
+}
+
+// PathValue returns a fluent assertion chain over a given wildcard of the route
+// pattern matched by http.ServeMux. Empty values are considered missing
+func (r *Request) PathValue(name string) *Param {
+	value := r.req.PathValue(name)
+	return newParam(r, name, []string{value}, value != "")
+}
+
+// MaxBodySize sets the maximum size in bytes of the bodies read by the Request,
+// and returns false if the declared content length of the request exceeds it.
+// Bodies of unknown length, e.g. chunked ones, fail once read past the size
+func (r *Request) MaxBodySize(n int64, msgArgs ...interface{}) bool {
+	r.maxBodySize = n
+	if r.req.ContentLength <= n {
+		return true
+	}
+
+	r.malformed = true
+	r.AddError(field(fieldBody, assertion.NewError("httpassert.maxbodysize", r.req.ContentLength, map[string]interface{}{"Max": n}, msgArgs...)))
+	return false
+}
+
+// ContentType returns true if the media type of the request is one of the given
+// types, e.g. ContentType("application/json"). Parameters like charset are
+// ignored
+func (r *Request) ContentType(types ...string) bool {
+	mediaType, _, _ := mime.ParseMediaType(r.req.Header.Get(fieldContentType))
+	for _, t := range types {
+		if strings.EqualFold(mediaType, t) {
+			return true
+		}
+	}
+
+	r.malformed = true
+	r.AddError(field(fieldContentType, assertion.NewError("httpassert.contenttype", mediaType, map[string]interface{}{"Types": types})))
+	return false
+}
+
+// JSON decodes the JSON body of the request into v, and returns false if the
+// body exceeds the maximum size or is not valid JSON for v. The body is kept
+// for the next readers of the request, e.g. the handler after a middleware
+func (r *Request) JSON(v interface{}, msgArgs ...interface{}) bool {
+	body, ok := r.body()
+	if !ok {
+		return false
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		r.malformed = true
+		r.AddError(field(fieldBody, assertion.NewError("httpassert.json", nil, map[string]interface{}{"Error": err}, msgArgs...)))
+		return false
+	}
+
+	return true
+}
+
+// Problem returns the problem details of the current errors, with the 400 Bad
+// Request status if the request format is invalid
+func (r *Request) Problem() assertion.Problem {
+	p := r.ProblemDetails()
+	if r.malformed {
+		p.Status = http.StatusBadRequest
+		p.Title = http.StatusText(http.StatusBadRequest)
+	}
+
+	return p
+}
+
+// WriteProblem writes the problem details of the current errors as the response
+// of the request
+func (r *Request) WriteProblem(w http.ResponseWriter) error {
+	p := r.Problem()
+	p.Instance = r.req.URL.Path
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set(fieldContentType, assertion.ProblemContentType)
+	w.WriteHeader(p.Status)
+	_, err = w.Write(data)
+	return err
+}
+
+// Validate returns a middleware that validates every request with a given
+// function. If any assertion fails it writes the problem details response,
+// otherwise it calls the next handler
+func Validate(fn func(r *Request), opts ...assertion.Option) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			r := New(req, opts...)
+			fn(r)
+			if r.HasErrors() {
+				_ = r.WriteProblem(w)
+				return
+			}
+
+			next.ServeHTTP(w, req)
+		})
+	}
+}
+
+// body reads the body of the request up to the maximum size and replaces it by
+// a reader of the same content
+func (r *Request) body() ([]byte, bool) {
+	if r.req.Body == nil || r.req.Body == http.NoBody {
+		return nil, true
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.req.Body, r.maxBodySize+1))
+	_ = r.req.Body.Close()
+	r.req.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		r.malformed = true
+		r.AddError(field(fieldBody, assertion.NewError("httpassert.json", nil, map[string]interface{}{"Error": err})))
+		return nil, false
+	}
+
+	if int64(len(body)) > r.maxBodySize {
+		r.tooLarge(int64(len(body)))
+		return nil, false
+	}
+
+	return body, true
+}
+
+// parseForm parses the form of the request unless it is already parsed, reading
+// the body up to the maximum size
+func (r *Request) parseForm() {
+	if r.req.Form != nil {
+		return
+	}
+
+	if r.req.Body != nil && r.req.Body != http.NoBody {
+		r.req.Body = http.MaxBytesReader(nil, r.req.Body, r.maxBodySize)
+	}
+
+	// ParseMultipartForm reports ErrNotMultipart instead of the errors reading
+	// url encoded bodies, so these are parsed first
+	err := r.req.ParseForm()
+	if err == nil {
+		err = r.req.ParseMultipartForm(r.maxBodySize)
+	}
+
+	var maxErr *http.MaxBytesError
+	switch {
+	case errors.As(err, &maxErr):
+		r.tooLarge(nil)
+	case err != nil && !errors.Is(err, http.ErrNotMultipart):
+		r.malformed = true
+		r.AddError(field(fieldForm, assertion.NewError("httpassert.form", nil, map[string]interface{}{"Error": err})))
+	}
+}
+
+// tooLarge records that the body of the request exceeds the maximum size, with a
+// given size read, nil if unknown
+func (r *Request) tooLarge(size interface{}) {
+	r.malformed = true
+	r.AddError(field(fieldBody, assertion.NewError("httpassert.maxbodysize", size, map[string]interface{}{"Max": r.maxBodySize})))
+}
+
+// field returns a given error as the error of a given field
+func field(name string, err *assertion.Error) *assertion.Error {
+	err.Field = name
+	return err
+}
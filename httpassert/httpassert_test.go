@@ -0,0 +1,179 @@
+package httpassert
+
+import (
+	"encoding/json"
+	"github.com/sangarbe/assertion"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestRequest_Query(t *testing.T) {
+	ha := New(httptest.NewRequest(http.MethodGet, "/items?page=2000&sort=name&q=", nil))
+
+	assert.False(t, ha.Query("page").Integer().Between(1, 1000).Valid())
+	assert.True(t, ha.Query("sort").OneOf("name", "date").Valid())
+	assert.True(t, ha.Query("limit").Integer().Between(1, 100).Valid())
+	assert.False(t, ha.Query("q").Required().MinLength(3).Valid())
+	assert.False(t, ha.Query("id").Required().Valid())
+
+	assert.Equal(t, 3, ha.CountErrors())
+	assert.EqualError(t, ha.ErrorAt(0), "page: 2000 is not between 1 and 1000")
+	assert.EqualError(t, ha.ErrorAt(1), "q: value is empty")
+	assert.EqualError(t, ha.ErrorAt(2), "id: value is empty")
+}
+
+func TestRequest_Query_NotNumber(t *testing.T) {
+	ha := New(httptest.NewRequest(http.MethodGet, "/items?page=abc", nil))
+
+	assert.False(t, ha.Query("page").Between(1, 1000).Valid())
+	assert.EqualError(t, ha.ErrorAt(0), "page: abc is not a valid float")
+}
+
+func TestRequest_Form(t *testing.T) {
+	form := url.Values{"email": {"foo"}, "name": {"bar"}}
+	req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	ha := New(req)
+
+	assert.False(t, ha.Form("email").Required().Email().Valid())
+	assert.True(t, ha.Form("name").Required().Alfanum().Valid())
+	assert.EqualError(t, ha.ErrorAt(0), "email: foo is not a valid email")
+}
+
+func TestRequest_Header(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Request-Id", "abc-123")
+	ha := New(req)
+
+	assert.True(t, ha.Header("X-Request-Id").Required().Matches(`^[a-z0-9-]+$`).Valid())
+	assert.False(t, ha.Header("Authorization").Required().Valid())
+	assert.EqualError(t, ha.ErrorAt(0), "Authorization: value is empty")
+}
+
+func TestRequest_PathValue(t *testing.T) {
+	var errs []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		ha := New(r)
+		ha.PathValue("id").Required().Unsigned()
+		for i := 0; i < ha.CountErrors(); i++ {
+			errs = append(errs, ha.ErrorAt(i).Error())
+		}
+	})
+
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/12", nil))
+	assert.Empty(t, errs)
+
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/x", nil))
+	assert.Equal(t, []string{"id: x is not a valid unsigned integer"}, errs)
+}
+
+func TestRequest_JSON(t *testing.T) {
+	var v struct {
+		Name string `json:"name"`
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name": "foo"}`))
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	ha := New(req)
+
+	assert.True(t, ha.ContentType("application/json"))
+	assert.True(t, ha.JSON(&v))
+	assert.Equal(t, "foo", v.Name)
+	assert.False(t, ha.HasErrors())
+
+	body, err := io.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name": "foo"}`, string(body))
+}
+
+func TestRequest_JSON_Invalid(t *testing.T) {
+	var v map[string]interface{}
+	ha := New(httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name":`)))
+
+	assert.False(t, ha.ContentType("application/json"))
+	assert.False(t, ha.JSON(&v))
+	assert.EqualError(t, ha.ErrorAt(0), "Content-Type:  is not one of [application/json]")
+	assert.EqualError(t, ha.ErrorAt(1), "body: invalid json: unexpected end of JSON input")
+	assert.Equal(t, http.StatusBadRequest, ha.Problem().Status)
+}
+
+func TestRequest_MaxBodySize(t *testing.T) {
+	var v map[string]interface{}
+
+	ha := New(httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name": "foo"}`)))
+	assert.False(t, ha.MaxBodySize(8))
+	assert.EqualError(t, ha.ErrorAt(0), "body: size exceeds 8 bytes")
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name": "foo"}`))
+	req.ContentLength = -1
+	ha = New(req)
+	assert.True(t, ha.MaxBodySize(8))
+	assert.False(t, ha.JSON(&v))
+	assert.EqualError(t, ha.ErrorAt(0), "body: size exceeds 8 bytes")
+}
+
+func TestRequest_MaxBodySize_ChunkedMultipart(t *testing.T) {
+	req := newMultipartRequest(t, map[string][2]string{"document": {"cv.pdf", strings.Repeat("x", 4096)}})
+	req.ContentLength = -1
+
+	ha := New(req)
+	assert.True(t, ha.MaxBodySize(1024))
+	assert.False(t, ha.File("document").Required().Valid())
+	assert.Equal(t, 2, ha.CountErrors())
+	assert.EqualError(t, ha.ErrorAt(0), "body: size exceeds 1024 bytes")
+	assert.EqualError(t, ha.ErrorAt(1), "document: value is empty")
+	assert.Equal(t, http.StatusBadRequest, ha.Problem().Status)
+
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader("name="+strings.Repeat("x", 64)))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.ContentLength = -1
+
+	ha = New(req)
+	ha.MaxBodySize(16)
+	ha.Form("name")
+	assert.EqualError(t, ha.Err(), "body: size exceeds 16 bytes")
+}
+
+func TestValidate(t *testing.T) {
+	handler := Validate(func(r *Request) {
+		r.Query("page").Integer().Between(1, 1000)
+	}, assertion.WithLocale("es"))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/items?page=5", nil))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/items?page=0", nil))
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, assertion.ProblemContentType, rec.Header().Get("Content-Type"))
+
+	var p assertion.Problem
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+	assert.Equal(t, "/items", p.Instance)
+	assert.Equal(t, []assertion.ProblemError{{
+		Field:   "page",
+		Rule:    "between",
+		Message: "0 no está entre 1 y 1000",
+		Params:  map[string]interface{}{"Min": 1.0, "Max": 1000.0},
+	}}, p.Errors)
+}
+
+func TestValidate_BadRequest(t *testing.T) {
+	handler := Validate(func(r *Request) {
+		var v map[string]interface{}
+		r.JSON(&v)
+	})(http.NotFoundHandler())
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`[`)))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,165 @@
+package httpassert
+
+import (
+	"github.com/sangarbe/assertion"
+	"strconv"
+)
+
+// Param is a fluent assertion chain over a request value identified by name.
+// Missing values are only checked by Required, any other assertion is skipped.
+// The chain stops on the first failure and its errors are prefixed by the name
+type Param struct {
+	assertion.Chain
+	value   string
+	present bool
+}
+
+// newParam returns a fluent assertion chain over the first of a given values
+func newParam(r *Request, name string, values []string, present bool) *Param {
+	p := &Param{Chain: assertion.NewChain(r.Assertion, name), present: present}
+	if len(values) > 0 {
+		p.value = values[0]
+	}
+
+	return p
+}
+
+// check runs a given assertion on the chain unless the value is missing
+func (p *Param) check(fn func(a *assertion.Assertion) bool) *Param {
+	if p.present {
+		p.Check(fn)
+	}
+
+	return p
+}
+
+// number runs a given assertion over the value parsed as a float, failing as
+// Float does if it is not a number
+func (p *Param) number(fn func(a *assertion.Assertion, f float64) bool, msgArgs ...interface{}) *Param {
+	return p.check(func(a *assertion.Assertion) bool {
+		f, err := strconv.ParseFloat(p.value, 64)
+		if err != nil {
+			return a.Float(p.value, msgArgs...)
+		}
+
+		return fn(a, f)
+	})
+}
+
+// Value returns the value, empty if it is missing
+func (p *Param) Value() string {
+	return p.value
+}
+
+// Required asserts that the value is present and not empty
+func (p *Param) Required(msgArgs ...interface{}) *Param {
+	p.Check(func(a *assertion.Assertion) bool {
+		if p.value != "" {
+			return true
+		}
+
+		a.AddError(assertion.NewError("required", p.value, nil, msgArgs...))
+		return false
+	})
+	return p
+}
+
+// Equal asserts that the value is equal to other string
+func (p *Param) Equal(other string, msgArgs ...interface{}) *Param {
+	return p.check(func(a *assertion.Assertion) bool {
+		return a.EqualString(p.value, other, assertion.CompareExact, msgArgs...)
+	})
+}
+
+// OneOf asserts that the value is equal to some of the given options
+func (p *Param) OneOf(options ...string) *Param {
+	return p.check(func(a *assertion.Assertion) bool { return assertion.OneOf(a, p.value, options) })
+}
+
+// MinLength asserts that the value has at least n runes
+func (p *Param) MinLength(n int, msgArgs ...interface{}) *Param {
+	return p.check(func(a *assertion.Assertion) bool { return a.MinLength(p.value, n, msgArgs...) })
+}
+
+// MaxLength asserts that the value has at most n runes
+func (p *Param) MaxLength(n int, msgArgs ...interface{}) *Param {
+	return p.check(func(a *assertion.Assertion) bool { return a.MaxLength(p.value, n, msgArgs...) })
+}
+
+// Matches asserts that the value matches a given pattern
+func (p *Param) Matches(pattern interface{}, msgArgs ...interface{}) *Param {
+	return p.check(func(a *assertion.Assertion) bool { return a.Matches(p.value, pattern, msgArgs...) })
+}
+
+// Email asserts that the value is a valid email
+func (p *Param) Email(msgArgs ...interface{}) *Param {
+	return p.check(func(a *assertion.Assertion) bool { return a.Email(p.value, msgArgs...) })
+}
+
+// Slug asserts that the value is a valid slug
+func (p *Param) Slug(msgArgs ...interface{}) *Param {
+	return p.check(func(a *assertion.Assertion) bool { return a.Slug(p.value, msgArgs...) })
+}
+
+// Alfanum asserts that the value is alfa-numeric
+func (p *Param) Alfanum(msgArgs ...interface{}) *Param {
+	return p.check(func(a *assertion.Assertion) bool { return a.Alfanum(p.value, msgArgs...) })
+}
+
+// Digits asserts that the value has only digits
+func (p *Param) Digits(msgArgs ...interface{}) *Param {
+	return p.check(func(a *assertion.Assertion) bool { return a.Digits(p.value, msgArgs...) })
+}
+
+// Boolean asserts that the value is a valid boolean string
+func (p *Param) Boolean(msgArgs ...interface{}) *Param {
+	return p.check(func(a *assertion.Assertion) bool { return a.Boolean(p.value, msgArgs...) })
+}
+
+// Integer asserts that the value is a valid integer
+func (p *Param) Integer(msgArgs ...interface{}) *Param {
+	return p.check(func(a *assertion.Assertion) bool { return a.Integer(p.value, msgArgs...) })
+}
+
+// Unsigned asserts that the value is a valid unsigned integer
+func (p *Param) Unsigned(msgArgs ...interface{}) *Param {
+	return p.check(func(a *assertion.Assertion) bool { return a.Unsigned(p.value, msgArgs...) })
+}
+
+// Float asserts that the value is a valid float
+func (p *Param) Float(msgArgs ...interface{}) *Param {
+	return p.check(func(a *assertion.Assertion) bool { return a.Float(p.value, msgArgs...) })
+}
+
+// GreaterThan asserts that the value is a number greater than other number
+func (p *Param) GreaterThan(other float64, msgArgs ...interface{}) *Param {
+	return p.number(func(a *assertion.Assertion, f float64) bool {
+		return assertion.GreaterThan(a, f, other, msgArgs...)
+	}, msgArgs...)
+}
+
+// LowerThan asserts that the value is a number lower than other number
+func (p *Param) LowerThan(other float64, msgArgs ...interface{}) *Param {
+	return p.number(func(a *assertion.Assertion, f float64) bool {
+		return assertion.LowerThan(a, f, other, msgArgs...)
+	}, msgArgs...)
+}
+
+// Between asserts that the value is a number between a lower and upper limit
+// numbers (including both)
+func (p *Param) Between(lower, upper float64, msgArgs ...interface{}) *Param {
+	return p.number(func(a *assertion.Assertion, f float64) bool {
+		return assertion.Between(a, f, lower, upper, msgArgs...)
+	}, msgArgs...)
+}
+
+// Rule asserts that the value satisfies a rule registered with RegisterRule
+func (p *Param) Rule(name string, params ...interface{}) *Param {
+	return p.check(func(a *assertion.Assertion) bool { return a.Rule(name, p.value, params) })
+}
+
+// Apply asserts that the value satisfies a compiled rule string, e.g.
+// assertion.MustCompile("minlen=3,alfanum")
+func (p *Param) Apply(rule *assertion.CompiledRule) *Param {
+	return p.check(func(a *assertion.Assertion) bool { return a.Apply(rule, p.value) })
+}
@@ -0,0 +1,259 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/sangarbe/assertion"
+	"math"
+	"regexp"
+	"sort"
+)
+
+// annotations are the keywords ignored by Compile, as they do not assert
+// anything about the documents
+var annotations = map[string]bool{
+	"$schema":     true,
+	"$id":         true,
+	"$anchor":     true,
+	"$comment":    true,
+	"$defs":       true,
+	"$vocabulary": true,
+	"title":       true,
+	"description": true,
+	"default":     true,
+	"examples":    true,
+	"deprecated":  true,
+	"readOnly":    true,
+	"writeOnly":   true,
+}
+
+// types are the valid values of the type keyword
+var types = map[string]bool{
+	"null":    true,
+	"boolean": true,
+	"object":  true,
+	"array":   true,
+	"number":  true,
+	"integer": true,
+	"string":  true,
+}
+
+// compile compiles a decoded schema at a given JSON Pointer of the document
+func compile(v interface{}, ptr string) (*Schema, error) {
+	switch v := v.(type) {
+	case bool:
+		return &Schema{allow: &v}, nil
+	case map[string]interface{}:
+		return compileObject(v, ptr)
+	}
+
+	return nil, fmt.Errorf(errMsgKeyword, pointerOrRoot(ptr), "schema must be an object or a boolean")
+}
+
+// compileObject compiles the keywords of an object schema, in a stable order
+// so that the reported error does not depend on the map iteration
+func compileObject(m map[string]interface{}, ptr string) (*Schema, error) {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	s := &Schema{}
+	for _, key := range keys {
+		if err := s.compileKeyword(key, m[key], ptr); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// compileKeyword compiles a keyword with a given value of the schema at a given
+// JSON Pointer
+func (s *Schema) compileKeyword(key string, v interface{}, parent string) error {
+	var err error
+	ptr := parent + "/" + assertion.EscapePointer(key)
+	switch key {
+	case "type":
+		s.types, err = compileTypes(v, ptr)
+	case "enum":
+		values, ok := v.([]interface{})
+		if !ok {
+			return fmt.Errorf(errMsgKeyword, ptr, "must be an array")
+		}
+		for _, value := range values {
+			s.enum = append(s.enum, normalize(value))
+		}
+	case "const":
+		s.constant, s.hasConst = normalize(v), true
+	case "minimum":
+		s.minimum, err = compileNumber(v, ptr)
+	case "maximum":
+		s.maximum, err = compileNumber(v, ptr)
+	case "exclusiveMinimum":
+		s.exclusiveMinimum, err = compileNumber(v, ptr)
+	case "exclusiveMaximum":
+		s.exclusiveMaximum, err = compileNumber(v, ptr)
+	case "minLength":
+		s.minLength, err = compileCount(v, ptr)
+	case "maxLength":
+		s.maxLength, err = compileCount(v, ptr)
+	case "minItems":
+		s.minItems, err = compileCount(v, ptr)
+	case "maxItems":
+		s.maxItems, err = compileCount(v, ptr)
+	case "pattern":
+		pattern, ok := v.(string)
+		if !ok {
+			return fmt.Errorf(errMsgKeyword, ptr, "must be a string")
+		}
+		if s.pattern, err = regexp.Compile(pattern); err != nil {
+			return fmt.Errorf(errMsgKeyword, ptr, err)
+		}
+	case "format":
+		format, ok := v.(string)
+		if !ok {
+			return fmt.Errorf(errMsgKeyword, ptr, "must be a string")
+		}
+		s.format = format
+	case "required":
+		s.required, err = compileStrings(v, ptr)
+	case "properties":
+		s.properties, err = compileProperties(v, ptr)
+	case "additionalProperties":
+		s.additionalProperties, err = compile(v, ptr)
+	case "items":
+		s.items, err = compile(v, ptr)
+	default:
+		if !annotations[key] {
+			return fmt.Errorf(errMsgUnsupported, pointerOrRoot(parent), key)
+		}
+	}
+
+	return err
+}
+
+// compileTypes compiles the value of the type keyword, a type name or an array
+// of them
+func compileTypes(v interface{}, ptr string) ([]string, error) {
+	if name, ok := v.(string); ok {
+		v = []interface{}{name}
+	}
+
+	names, err := compileStrings(v, ptr)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range names {
+		if !types[name] {
+			return nil, fmt.Errorf(errMsgKeyword, ptr, "unknown type "+name)
+		}
+	}
+
+	return names, nil
+}
+
+// compileNumber compiles a number keyword
+func compileNumber(v interface{}, ptr string) (*float64, error) {
+	f, ok := number(v)
+	if !ok {
+		return nil, fmt.Errorf(errMsgKeyword, ptr, "must be a number")
+	}
+
+	return &f, nil
+}
+
+// compileCount compiles a non negative integer keyword
+func compileCount(v interface{}, ptr string) (*int, error) {
+	f, ok := number(v)
+	if !ok || f < 0 || f != math.Trunc(f) || f > math.MaxInt32 {
+		return nil, fmt.Errorf(errMsgKeyword, ptr, "must be a non negative integer")
+	}
+
+	n := int(f)
+	return &n, nil
+}
+
+// compileStrings compiles an array of strings keyword
+func compileStrings(v interface{}, ptr string) ([]string, error) {
+	values, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf(errMsgKeyword, ptr, "must be an array of strings")
+	}
+
+	strs := make([]string, 0, len(values))
+	for _, value := range values {
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf(errMsgKeyword, ptr, "must be an array of strings")
+		}
+		strs = append(strs, s)
+	}
+
+	return strs, nil
+}
+
+// compileProperties compiles the schemas of the properties keyword
+func compileProperties(v interface{}, ptr string) (map[string]*Schema, error) {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf(errMsgKeyword, ptr, "must be an object")
+	}
+
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	properties := make(map[string]*Schema, len(m))
+	for _, name := range names {
+		s, err := compile(m[name], ptr+"/"+assertion.EscapePointer(name))
+		if err != nil {
+			return nil, err
+		}
+		properties[name] = s
+	}
+
+	return properties, nil
+}
+
+// normalize returns a decoded JSON value with its numbers as float64, so that
+// documents decoded with or without json.Decoder.UseNumber compare equal
+func normalize(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[key] = normalize(value)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, value := range v {
+			s[i] = normalize(value)
+		}
+		return s
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+	}
+
+	if f, ok := number(v); ok {
+		return f
+	}
+
+	return v
+}
+
+// pointerOrRoot returns a given JSON Pointer, or "#" for the root document
+func pointerOrRoot(ptr string) string {
+	if ptr == "" {
+		return "#"
+	}
+
+	return ptr
+}
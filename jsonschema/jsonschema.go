@@ -0,0 +1,155 @@
+// Package jsonschema validates decoded JSON documents against a subset of JSON
+// Schema draft 2020-12 with the assertion rules, e.g.
+//
+//	schema := jsonschema.MustCompile([]byte(`{"type": "object", "required": ["email"]}`))
+//	err := schema.Validate(doc)
+//
+// The supported keywords are type, enum, const, minimum, maximum,
+// exclusiveMinimum, exclusiveMaximum, minLength, maxLength, pattern, format,
+// required, properties, additionalProperties, items, minItems and maxItems.
+// Annotations such as title or $defs are ignored, while any other applicator
+// or validation keyword, e.g. $ref or anyOf, is rejected by Compile.
+//
+// The format keyword is asserted with the matching Assertion method for
+// email, hostname, ipv4, ipv6, uri, uuid, date-time and date, and ignored for
+// any other format. The pattern keyword is compiled with the RE2 syntax of the
+// regexp package rather than ECMA-262, so lookarounds and backreferences are
+// rejected by Compile. Each failure is reported with the JSON Pointer of the
+// value as its field, e.g. "/items/0/price: 0 is not greater than 0".
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/sangarbe/assertion"
+	"regexp"
+)
+
+const (
+	errMsgSchema      = `jsonschema: %w`
+	errMsgKeyword     = `jsonschema: %s: %s`
+	errMsgUnsupported = `jsonschema: %s: unsupported keyword %s`
+)
+
+func init() {
+	assertion.RegisterMessages("en", map[string]string{
+		"jsonschema.type":               `{{.Value}} is not of type {{.Type}}`,
+		"jsonschema.requiredproperty":   `property is required`,
+		"jsonschema.additionalproperty": `property is not allowed`,
+		"jsonschema.minitems":           `has fewer than {{.Min}} items`,
+		"jsonschema.maxitems":           `has more than {{.Max}} items`,
+		"jsonschema.false":              `value is not allowed`,
+	})
+	assertion.RegisterMessages("es", map[string]string{
+		"jsonschema.type":               `{{.Value}} no es de tipo {{.Type}}`,
+		"jsonschema.requiredproperty":   `la propiedad es obligatoria`,
+		"jsonschema.additionalproperty": `la propiedad no está permitida`,
+		"jsonschema.minitems":           `tiene menos de {{.Min}} elementos`,
+		"jsonschema.maxitems":           `tiene más de {{.Max}} elementos`,
+		"jsonschema.false":              `el valor no está permitido`,
+	})
+	assertion.RegisterMessages("fr", map[string]string{
+		"jsonschema.type":               `{{.Value}} n'est pas de type {{.Type}}`,
+		"jsonschema.requiredproperty":   `la propriété est obligatoire`,
+		"jsonschema.additionalproperty": `la propriété n'est pas autorisée`,
+		"jsonschema.minitems":           `a moins de {{.Min}} éléments`,
+		"jsonschema.maxitems":           `a plus de {{.Max}} éléments`,
+		"jsonschema.false":              `la valeur n'est pas autorisée`,
+	})
+	assertion.RegisterMessages("de", map[string]string{
+		"jsonschema.type":               `{{.Value}} ist nicht vom Typ {{.Type}}`,
+		"jsonschema.requiredproperty":   `die Eigenschaft ist erforderlich`,
+		"jsonschema.additionalproperty": `die Eigenschaft ist nicht erlaubt`,
+		"jsonschema.minitems":           `hat weniger als {{.Min}} Elemente`,
+		"jsonschema.maxitems":           `hat mehr als {{.Max}} Elemente`,
+		"jsonschema.false":              `der Wert ist nicht erlaubt`,
+	})
+	assertion.RegisterMessages("pt", map[string]string{
+		"jsonschema.type":               `{{.Value}} não é do tipo {{.Type}}`,
+		"jsonschema.requiredproperty":   `a propriedade é obrigatória`,
+		"jsonschema.additionalproperty": `a propriedade não é permitida`,
+		"jsonschema.minitems":           `tem menos de {{.Min}} itens`,
+		"jsonschema.maxitems":           `tem mais de {{.Max}} itens`,
+		"jsonschema.false":              `o valor não é permitido`,
+	})
+}
+
+// formats are the Assertion methods that assert the supported formats
+var formats = map[string]func(*assertion.Assertion, string, ...interface{}) bool{
+	"email":     (*assertion.Assertion).Email,
+	"hostname":  (*assertion.Assertion).Hostname,
+	"ipv4":      (*assertion.Assertion).Ipv4,
+	"ipv6":      (*assertion.Assertion).Ipv6,
+	"uri":       (*assertion.Assertion).URI,
+	"uuid":      (*assertion.Assertion).UUID,
+	"date-time": (*assertion.Assertion).DateTime,
+	"date":      (*assertion.Assertion).Date,
+}
+
+// Schema is a compiled JSON Schema
+type Schema struct {
+	allow                *bool
+	types                []string
+	enum                 []interface{}
+	constant             interface{}
+	hasConst             bool
+	minimum              *float64
+	maximum              *float64
+	exclusiveMinimum     *float64
+	exclusiveMaximum     *float64
+	minLength            *int
+	maxLength            *int
+	pattern              *regexp.Regexp
+	format               string
+	required             []string
+	properties           map[string]*Schema
+	additionalProperties *Schema
+	items                *Schema
+	minItems             *int
+	maxItems             *int
+}
+
+// Compile parses a given JSON Schema document and returns the compiled Schema,
+// or an error if it is not valid JSON or uses unsupported keywords
+func Compile(data []byte) (*Schema, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf(errMsgSchema, err)
+	}
+
+	return compile(v, "")
+}
+
+// MustCompile is like Compile but panics if the schema cannot be compiled
+func MustCompile(data []byte) *Schema {
+	s, err := Compile(data)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// Assert returns true if a given decoded JSON document is valid against the
+// schema, otherwise records an error on the Assertion for every failure
+func (s *Schema) Assert(a *assertion.Assertion, doc interface{}) bool {
+	c := assertion.NewChain(a, "")
+	return c.Check(func(a *assertion.Assertion) bool {
+		s.validate(a, doc, "")
+		return !a.HasErrors()
+	})
+}
+
+// Validate returns nil if a given decoded JSON document is valid against the
+// schema, otherwise an error listing every failure, in the locale of the given
+// options
+func (s *Schema) Validate(doc interface{}, opts ...assertion.Option) error {
+	a := assertion.New(opts...)
+	s.Assert(&a, doc)
+
+	return a.Err()
+}
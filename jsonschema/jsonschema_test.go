@@ -0,0 +1,154 @@
+package jsonschema
+
+import (
+	_ "embed"
+	"encoding/json"
+	"github.com/sangarbe/assertion"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+//go:embed testdata/order.schema.json
+var orderSchema []byte
+
+func decode(t *testing.T, doc string, useNumber bool) interface{} {
+	dec := json.NewDecoder(strings.NewReader(doc))
+	if useNumber {
+		dec.UseNumber()
+	}
+
+	var v interface{}
+	assert.NoError(t, dec.Decode(&v))
+	return v
+}
+
+func TestSchema_Validate(t *testing.T) {
+	schema := MustCompile(orderSchema)
+	doc := `{
+  "id": "123e4567-e89b-12d3-a456-426614174000",
+  "email": "foo@example.com",
+  "status": "paid",
+  "version": 1.0,
+  "createdAt": "2024-01-31T23:59:59Z",
+  "coupon": null,
+  "note": "leave at the door",
+  "items": [{"sku": "abc-1", "price": 9.99, "quantity": 2}]
+}`
+
+	for _, useNumber := range []bool{false, true} {
+		assert.NoError(t, schema.Validate(decode(t, doc, useNumber)))
+	}
+}
+
+func TestSchema_Validate_Errors(t *testing.T) {
+	schema := MustCompile(orderSchema)
+	doc := `{
+  "id": "123",
+  "status": "lost",
+  "version": 2,
+  "createdAt": "2024-01-31",
+  "coupon": "free",
+  "gift/wrap": true,
+  "items": [
+    {"sku": "abc-1", "price": 0, "quantity": 1.5},
+    {"sku": "ab", "price": "9.99", "color": "red"},
+    {"price": 1, "quantity": 100}
+  ]
+}`
+
+	for _, useNumber := range []bool{false, true} {
+		a := assertion.New()
+		assert.False(t, schema.Assert(&a, decode(t, doc, useNumber)))
+		assert.EqualError(t, a.Err(), "/email: property is required; "+
+			"/coupon: free does not match ^[A-Z]{4}[0-9]{2}$; "+
+			"/createdAt: 2024-01-31 is not a valid date-time; "+
+			"/gift~1wrap: true is not of type string; "+
+			"/id: 123 is not a valid uuid; "+
+			"/items/0/price: 0 is not greater than 0; "+
+			"/items/0/quantity: 1.5 is not of type integer; "+
+			"/items/1/color: property is not allowed; "+
+			"/items/1/price: 9.99 is not of type number; "+
+			"/items/1/sku: ab is shorter than 3; "+
+			"/items/2/sku: property is required; "+
+			"/items/2/quantity: 100 is not lower than or equal 99; "+
+			"/status: lost is not one of [pending paid shipped]; "+
+			"/version: 2 is not equal 1")
+	}
+}
+
+func TestSchema_Validate_Locale(t *testing.T) {
+	schema := MustCompile([]byte(`{"type": "array", "minItems": 2, "items": {"type": ["integer", "null"]}}`))
+
+	err := schema.Validate([]interface{}{"a"}, assertion.WithLocale("es"))
+	assert.EqualError(t, err, "tiene menos de 2 elementos; /0: a no es de tipo [integer null]")
+}
+
+func TestSchema_Validate_RuleNames(t *testing.T) {
+	assertion.RegisterRule("type", func(value interface{}, params ...interface{}) bool { return false }, "%v has a wrong product type")
+
+	a := assertion.New()
+	a.Rule("type", "sku-1", nil)
+	assert.EqualError(t, a.ErrorAt(0), "sku-1 has a wrong product type")
+
+	err := MustCompile([]byte(`{"type": "string"}`)).Validate(1.0)
+	assert.EqualError(t, err, "1 is not of type string")
+}
+
+func TestSchema_Validate_BooleanSchemas(t *testing.T) {
+	assert.NoError(t, MustCompile([]byte(`true`)).Validate(map[string]interface{}{"foo": 1}))
+	assert.EqualError(t, MustCompile([]byte(`false`)).Validate(nil), "value is not allowed")
+	assert.EqualError(t, MustCompile([]byte(`{"properties": {"foo": false}}`)).Validate(map[string]interface{}{"foo": 1}), "/foo: value is not allowed")
+}
+
+func TestSchema_Validate_Formats(t *testing.T) {
+	schema := MustCompile([]byte(`{"type": "array", "items": {"type": "string", "maxLength": 20, "format": "hostname"}}`))
+	assert.NoError(t, schema.Validate([]interface{}{"example.com", "db-1"}))
+	assert.EqualError(t, schema.Validate([]interface{}{"foo_bar"}), "/0: foo_bar is not a valid hostname")
+
+	formats := map[string]string{
+		"ipv4":     "::1",
+		"ipv6":     "127.0.0.1",
+		"uri":      "example.com",
+		"date":     "2023-02-29",
+		"unknown":  "anything",
+		"email":    "foo@example.com",
+		"duration": "P1D",
+	}
+	valid := map[string]bool{"unknown": true, "email": true, "duration": true}
+	for format, value := range formats {
+		err := MustCompile([]byte(`{"format": "` + format + `"}`)).Validate(value)
+		assert.Equal(t, valid[format], err == nil, format)
+	}
+
+	uri := MustCompile([]byte(`{"format": "uri"}`))
+	for _, value := range []string{"urn:isbn:0451450523", "mailto:a@b.c", "https://example.com"} {
+		assert.NoError(t, uri.Validate(value), value)
+	}
+}
+
+func TestCompile_Errors(t *testing.T) {
+	tests := []struct {
+		schema string
+		err    string
+	}{
+		{`{"type": `, "jsonschema: unexpected EOF"},
+		{`[]`, "jsonschema: #: schema must be an object or a boolean"},
+		{`{"type": "float"}`, "jsonschema: /type: unknown type float"},
+		{`{"minLength": -1}`, "jsonschema: /minLength: must be a non negative integer"},
+		{`{"maximum": "10"}`, "jsonschema: /maximum: must be a number"},
+		{`{"pattern": "("}`, "jsonschema: /pattern: error parsing regexp: missing closing ): `(`"},
+		{`{"pattern": "^(?!tmp)"}`, "jsonschema: /pattern: error parsing regexp: invalid or unsupported Perl syntax: `(?!`"},
+		{`{"required": ["a", 1]}`, "jsonschema: /required: must be an array of strings"},
+		{`{"properties": {"a/b": {"items": 1}}}`, "jsonschema: /properties/a~1b/items: schema must be an object or a boolean"},
+		{`{"$ref": "#/$defs/item"}`, "jsonschema: #: unsupported keyword $ref"},
+		{`{"items": {"anyOf": []}}`, "jsonschema: /items: unsupported keyword anyOf"},
+	}
+
+	for _, tt := range tests {
+		_, err := Compile([]byte(tt.schema))
+		assert.EqualError(t, err, tt.err, tt.schema)
+	}
+
+	assert.Panics(t, func() { MustCompile([]byte(`{"$ref": "#"}`)) })
+}
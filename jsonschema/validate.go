@@ -0,0 +1,226 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"github.com/sangarbe/assertion"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+// validate records an error on the Assertion for every failure of a given
+// value at a given JSON Pointer
+func (s *Schema) validate(a *assertion.Assertion, v interface{}, ptr string) {
+	if s.allow != nil {
+		if !*s.allow {
+			fail(a, ptr, "jsonschema.false", v, nil)
+		}
+		return
+	}
+
+	if len(s.types) > 0 && !s.validateType(a, v, ptr) {
+		return
+	}
+
+	if len(s.enum) > 0 && !contains(s.enum, normalize(v)) {
+		fail(a, ptr, "oneof", v, map[string]interface{}{"Options": s.enum})
+	}
+
+	if s.hasConst && !reflect.DeepEqual(s.constant, normalize(v)) {
+		fail(a, ptr, "equal", v, map[string]interface{}{"Other": s.constant})
+	}
+
+	switch v := v.(type) {
+	case string:
+		s.validateString(a, v, ptr)
+	case []interface{}:
+		s.validateArray(a, v, ptr)
+	case map[string]interface{}:
+		s.validateObject(a, v, ptr)
+	case bool, nil:
+	default:
+		if f, ok := number(v); ok {
+			s.validateNumber(a, v, f, ptr)
+		}
+	}
+}
+
+// validateType returns true if a given value is of one of the schema types,
+// otherwise records an error
+func (s *Schema) validateType(a *assertion.Assertion, v interface{}, ptr string) bool {
+	for _, name := range s.types {
+		if isType(v, name) {
+			return true
+		}
+	}
+
+	var typ interface{} = s.types
+	if len(s.types) == 1 {
+		typ = s.types[0]
+	}
+
+	fail(a, ptr, "jsonschema.type", v, map[string]interface{}{"Type": typ})
+	return false
+}
+
+// validateNumber validates the number keywords
+func (s *Schema) validateNumber(a *assertion.Assertion, v interface{}, f float64, ptr string) {
+	if s.minimum != nil && f < *s.minimum {
+		fail(a, ptr, "gte", v, map[string]interface{}{"Other": *s.minimum})
+	}
+
+	if s.maximum != nil && f > *s.maximum {
+		fail(a, ptr, "lte", v, map[string]interface{}{"Other": *s.maximum})
+	}
+
+	if s.exclusiveMinimum != nil && f <= *s.exclusiveMinimum {
+		fail(a, ptr, "gt", v, map[string]interface{}{"Other": *s.exclusiveMinimum})
+	}
+
+	if s.exclusiveMaximum != nil && f >= *s.exclusiveMaximum {
+		fail(a, ptr, "lt", v, map[string]interface{}{"Other": *s.exclusiveMaximum})
+	}
+}
+
+// validateString validates the string keywords
+func (s *Schema) validateString(a *assertion.Assertion, v string, ptr string) {
+	n := utf8.RuneCountInString(v)
+	if s.minLength != nil && n < *s.minLength {
+		fail(a, ptr, "minlen", v, map[string]interface{}{"Min": *s.minLength})
+	}
+
+	if s.maxLength != nil && n > *s.maxLength {
+		fail(a, ptr, "maxlen", v, map[string]interface{}{"Max": *s.maxLength})
+	}
+
+	if s.pattern != nil && !s.pattern.MatchString(v) {
+		fail(a, ptr, "matches", v, map[string]interface{}{"Pattern": s.pattern})
+	}
+
+	if format, ok := formats[s.format]; ok {
+		c := assertion.NewChain(a, ptr)
+		c.Check(func(a *assertion.Assertion) bool { return format(a, v) })
+	}
+}
+
+// validateArray validates the array keywords and the schema of every item
+func (s *Schema) validateArray(a *assertion.Assertion, v []interface{}, ptr string) {
+	if s.minItems != nil && len(v) < *s.minItems {
+		fail(a, ptr, "jsonschema.minitems", v, map[string]interface{}{"Min": *s.minItems})
+	}
+
+	if s.maxItems != nil && len(v) > *s.maxItems {
+		fail(a, ptr, "jsonschema.maxitems", v, map[string]interface{}{"Max": *s.maxItems})
+	}
+
+	if s.items != nil {
+		for i, item := range v {
+			s.items.validate(a, item, ptr+"/"+strconv.Itoa(i))
+		}
+	}
+}
+
+// validateObject validates the required properties and the schema of every
+// property, in the order of their names
+func (s *Schema) validateObject(a *assertion.Assertion, v map[string]interface{}, ptr string) {
+	for _, name := range s.required {
+		if _, ok := v[name]; !ok {
+			fail(a, ptr+"/"+assertion.EscapePointer(name), "jsonschema.requiredproperty", nil, nil)
+		}
+	}
+
+	names := make([]string, 0, len(v))
+	for name := range v {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if property, ok := s.properties[name]; ok {
+			property.validate(a, v[name], ptr+"/"+assertion.EscapePointer(name))
+		} else if s.additionalProperties != nil {
+			if allow := s.additionalProperties.allow; allow != nil && !*allow {
+				fail(a, ptr+"/"+assertion.EscapePointer(name), "jsonschema.additionalproperty", v[name], nil)
+				continue
+			}
+			s.additionalProperties.validate(a, v[name], ptr+"/"+assertion.EscapePointer(name))
+		}
+	}
+}
+
+// fail records an error of a given rule for a value at a given JSON Pointer
+func fail(a *assertion.Assertion, ptr, rule string, v interface{}, params map[string]interface{}) {
+	addError(a, ptr, assertion.NewError(rule, v, params))
+}
+
+// addError records a given error for a value at a given JSON Pointer
+func addError(a *assertion.Assertion, ptr string, err error) {
+	if e, ok := err.(*assertion.Error); ok {
+		e.Field = ptr
+	}
+
+	a.AddError(err)
+}
+
+// isType returns true if a given decoded JSON value is of a given type name.
+// Numbers with a zero fractional part are integers
+func isType(v interface{}, name string) bool {
+	switch name {
+	case "null":
+		return v == nil
+	case "boolean":
+		_, ok := v.(bool)
+		return ok
+	case "object":
+		_, ok := v.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := v.([]interface{})
+		return ok
+	case "string":
+		_, ok := v.(string)
+		return ok
+	case "number":
+		_, ok := number(v)
+		return ok
+	case "integer":
+		f, ok := number(v)
+		return ok && f == math.Trunc(f) && !math.IsInf(f, 0)
+	}
+
+	return false
+}
+
+// number returns the float64 of a numeric value, either a json.Number or any
+// Go integer or float
+func number(v interface{}) (float64, bool) {
+	if n, ok := v.(json.Number); ok {
+		f, err := n.Float64()
+		return f, err == nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+
+	return 0, false
+}
+
+// contains returns true if a given normalized value is one of the values
+func contains(values []interface{}, v interface{}) bool {
+	for _, value := range values {
+		if reflect.DeepEqual(value, v) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,369 @@
+package assertion
+
+import (
+	"strings"
+)
+
+// Translator provides the message templates of the rules in other languages
+type Translator interface {
+	// Translate returns the message template of a given rule in a given locale,
+	// and false if there is no translation
+	Translate(locale, rule string) (string, bool)
+}
+
+// Catalog is a Translator holding the message templates by locale and rule, e.g.
+// Catalog{"es": {"email": "{{.Value}} no es un email válido"}}. Regional
+// locales fall back to their language, e.g. "es-AR" to "es"
+type Catalog map[string]map[string]string
+
+// Translate returns the message template of a given rule in a given locale
+func (c Catalog) Translate(locale, rule string) (string, bool) {
+	for _, l := range localeCandidates(locale) {
+		if template, ok := c[l][rule]; ok {
+			return template, true
+		}
+	}
+
+	return "", false
+}
+
+// WithLocale returns an Option that makes the Assertion render its error
+// messages in a given locale. Built-in catalogues are en, es, fr, de and pt.
+// Rules without translation keep the default message
+func WithLocale(locale string) Option {
+	return func(a *Assertion) {
+		a.locale = locale
+	}
+}
+
+// WithTranslator returns an Option that makes the Assertion look up the message
+// templates in a given Translator before the built-in catalogues
+func WithTranslator(t Translator) Option {
+	return func(a *Assertion) {
+		a.translator = t
+	}
+}
+
+// localeCandidates returns the locales to look up for a given locale, from the
+// most to the least specific, e.g. "pt_BR" results in "pt-br" and "pt"
+func localeCandidates(locale string) []string {
+	locale = strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	if i := strings.Index(locale, "-"); i > 0 {
+		return []string{locale, locale[:i]}
+	}
+
+	return []string{locale}
+}
+
+// builtinCatalog holds the built-in translations of the default messages. It
+// is never modified, see RegisterMessages
+var builtinCatalog = Catalog{
+	"es": {
+		"nil":                 `{{.Value}} no es <nil>`,
+		"sametype":            `{{.Value}} y {{.Other}} no son del mismo tipo`,
+		"equal":               `{{.Value}} no es igual a {{.Other}}`,
+		"different":           `{{.Value}} no es distinto de {{.Other}}`,
+		"gt":                  `{{.Value}} no es mayor que {{.Other}}`,
+		"gte":                 `{{.Value}} no es mayor o igual que {{.Other}}`,
+		"lt":                  `{{.Value}} no es menor que {{.Other}}`,
+		"lte":                 `{{.Value}} no es menor o igual que {{.Other}}`,
+		"between":             `{{.Value}} no está entre {{.Min}} y {{.Max}}`,
+		"betweenexclude":      `{{.Value}} no está entre {{.Min}} y {{.Max}} ambos excluidos`,
+		"oneof":               `{{.Value}} no es uno de {{.Options}}`,
+		"length":              `{{.Value}} no tiene longitud`,
+		"minlen":              `{{.Value}} es más corto que {{.Min}}`,
+		"maxlen":              `{{.Value}} es más largo que {{.Max}}`,
+		"required":            `el valor está vacío`,
+		"missing":             `falta el valor`,
+		"check":               `la comprobación ha fallado`,
+		"any":                 `ninguna alternativa se cumple: {{.Errors}}`,
+		"none":                `las alternativas {{.Satisfied}} se cumplen`,
+		"mx":                  `{{.Value}} no tiene registros mx`,
+		"string":              `{{.Value}} no es una cadena válida`,
+		"number":              `{{.Value}} no es un número válido`,
+		"boolean":             `{{.Value}} no es una cadena booleana válida`,
+		"truthy":              `{{.Value}} no es una cadena verdadera válida`,
+		"falsy":               `{{.Value}} no es una cadena falsa válida`,
+		"integer":             `{{.Value}} no es un entero válido`,
+		"integerbinary":       `{{.Value}} no es un entero válido en base 2`,
+		"integeroctal":        `{{.Value}} no es un entero válido en base 8`,
+		"integerhexadecimal":  `{{.Value}} no es un entero válido en base 16`,
+		"integerdecimal":      `{{.Value}} no es un entero válido en base 10`,
+		"unsigned":            `{{.Value}} no es un entero sin signo válido`,
+		"unsignedbinary":      `{{.Value}} no es un entero sin signo válido en base 2`,
+		"unsignedoctal":       `{{.Value}} no es un entero sin signo válido en base 8`,
+		"unsignedhexadecimal": `{{.Value}} no es un entero sin signo válido en base 16`,
+		"unsigneddecimal":     `{{.Value}} no es un entero sin signo válido en base 10`,
+		"float":               `{{.Value}} no es un decimal válido`,
+		"base64":              `{{.Value}} no es un valor válido codificado en base64`,
+		"alfanum":             `{{.Value}} no es alfanumérico`,
+		"digits":              `{{.Value}} no contiene solo dígitos`,
+		"letters":             `{{.Value}} no contiene solo letras`,
+		"alfanumascii":        `{{.Value}} no es alfanumérico ascii`,
+		"digitsascii":         `{{.Value}} no contiene solo dígitos ascii`,
+		"lettersascii":        `{{.Value}} no contiene solo letras ascii`,
+		"ascii":               `{{.Value}} no es ascii`,
+		"printableascii":      `{{.Value}} no es ascii imprimible`,
+		"lowercase":           `{{.Value}} no está en minúsculas`,
+		"uppercase":           `{{.Value}} no está en mayúsculas`,
+		"nowhitespace":        `{{.Value}} contiene espacios en blanco`,
+		"nocontrolchars":      `{{.Value}} contiene caracteres de control`,
+		"utf8":                `{{.Value}} no es una cadena utf-8 válida`,
+		"hexadecimal":         `{{.Value}} no es hexadecimal`,
+		"slug":                `{{.Value}} no es un slug válido`,
+		"onlyrunes":           `{{.Value}} contiene caracteres no permitidos`,
+		"email":               `{{.Value}} no es un email válido`,
+		"phone":               `{{.Value}} no es un teléfono válido`,
+		"ipv4":                `{{.Value}} no es una ipv4 válida`,
+		"url":                 `{{.Value}} no es una url válida`,
+		"uri":                 `{{.Value}} no es una uri válida`,
+		"hostname":            `{{.Value}} no es un nombre de host válido`,
+		"ipv6":                `{{.Value}} no es una ipv6 válida`,
+		"uuid":                `{{.Value}} no es un uuid válido`,
+		"datetime":            `{{.Value}} no es una fecha y hora válida`,
+		"date":                `{{.Value}} no es una fecha válida`,
+		"matches":             `{{.Value}} no coincide con {{.Pattern}}`,
+		"notmatches":          `{{.Value}} coincide con {{.Pattern}}`,
+		"not":                 `{{.Value}} cumple {{.Name}}`,
+		"startswith":          `{{.Value}} no empieza por {{.Needle}}`,
+		"endswith":            `{{.Value}} no termina en {{.Needle}}`,
+		"contains":            `{{.Value}} no contiene {{.Needle}}`,
+		"haskey":              `{{.Value}} no tiene la clave {{.Key}}`,
+		"haskeys":             `{{.Value}} no tiene las claves {{.Keys}}`,
+		"hasonlykeys":         `{{.Value}} tiene claves inesperadas {{.Keys}}`,
+		"hasvalue":            `{{.Value}} no tiene el valor {{.Element}}`,
+		"keymatches":          `{{.Value}} tiene claves {{.Keys}} que no coinciden con {{.Pattern}}`,
+		"haskeyvalue":         `{{.Value}} no tiene la clave {{.Key}} con valor {{.Element}}`,
+	},
+	"fr": {
+		"nil":                 `{{.Value}} n'est pas <nil>`,
+		"sametype":            `{{.Value}} et {{.Other}} ne sont pas du même type`,
+		"equal":               `{{.Value}} n'est pas égal à {{.Other}}`,
+		"different":           `{{.Value}} n'est pas différent de {{.Other}}`,
+		"gt":                  `{{.Value}} n'est pas supérieur à {{.Other}}`,
+		"gte":                 `{{.Value}} n'est pas supérieur ou égal à {{.Other}}`,
+		"lt":                  `{{.Value}} n'est pas inférieur à {{.Other}}`,
+		"lte":                 `{{.Value}} n'est pas inférieur ou égal à {{.Other}}`,
+		"between":             `{{.Value}} n'est pas entre {{.Min}} et {{.Max}}`,
+		"betweenexclude":      `{{.Value}} n'est pas entre {{.Min}} et {{.Max}} tous deux exclus`,
+		"oneof":               `{{.Value}} n'est pas l'un de {{.Options}}`,
+		"length":              `{{.Value}} n'a pas de longueur`,
+		"minlen":              `{{.Value}} est plus court que {{.Min}}`,
+		"maxlen":              `{{.Value}} est plus long que {{.Max}}`,
+		"required":            `la valeur est vide`,
+		"missing":             `la valeur est manquante`,
+		"check":               `la vérification a échoué`,
+		"any":                 `aucune alternative n'est satisfaite : {{.Errors}}`,
+		"none":                `les alternatives {{.Satisfied}} sont satisfaites`,
+		"mx":                  `{{.Value}} n'a pas d'enregistrements mx`,
+		"string":              `{{.Value}} n'est pas une chaîne valide`,
+		"number":              `{{.Value}} n'est pas un nombre valide`,
+		"boolean":             `{{.Value}} n'est pas une chaîne booléenne valide`,
+		"truthy":              `{{.Value}} n'est pas une chaîne vraie valide`,
+		"falsy":               `{{.Value}} n'est pas une chaîne fausse valide`,
+		"integer":             `{{.Value}} n'est pas un entier valide`,
+		"integerbinary":       `{{.Value}} n'est pas un entier valide en base 2`,
+		"integeroctal":        `{{.Value}} n'est pas un entier valide en base 8`,
+		"integerhexadecimal":  `{{.Value}} n'est pas un entier valide en base 16`,
+		"integerdecimal":      `{{.Value}} n'est pas un entier valide en base 10`,
+		"unsigned":            `{{.Value}} n'est pas un entier non signé valide`,
+		"unsignedbinary":      `{{.Value}} n'est pas un entier non signé valide en base 2`,
+		"unsignedoctal":       `{{.Value}} n'est pas un entier non signé valide en base 8`,
+		"unsignedhexadecimal": `{{.Value}} n'est pas un entier non signé valide en base 16`,
+		"unsigneddecimal":     `{{.Value}} n'est pas un entier non signé valide en base 10`,
+		"float":               `{{.Value}} n'est pas un nombre décimal valide`,
+		"base64":              `{{.Value}} n'est pas une valeur encodée en base64 valide`,
+		"alfanum":             `{{.Value}} n'est pas alphanumérique`,
+		"digits":              `{{.Value}} ne contient pas que des chiffres`,
+		"letters":             `{{.Value}} ne contient pas que des lettres`,
+		"alfanumascii":        `{{.Value}} n'est pas alphanumérique ascii`,
+		"digitsascii":         `{{.Value}} ne contient pas que des chiffres ascii`,
+		"lettersascii":        `{{.Value}} ne contient pas que des lettres ascii`,
+		"ascii":               `{{.Value}} n'est pas ascii`,
+		"printableascii":      `{{.Value}} n'est pas ascii imprimable`,
+		"lowercase":           `{{.Value}} n'est pas en minuscules`,
+		"uppercase":           `{{.Value}} n'est pas en majuscules`,
+		"nowhitespace":        `{{.Value}} contient des espaces`,
+		"nocontrolchars":      `{{.Value}} contient des caractères de contrôle`,
+		"utf8":                `{{.Value}} n'est pas une chaîne utf-8 valide`,
+		"hexadecimal":         `{{.Value}} n'est pas hexadécimal`,
+		"slug":                `{{.Value}} n'est pas un slug valide`,
+		"onlyrunes":           `{{.Value}} contient des caractères non autorisés`,
+		"email":               `{{.Value}} n'est pas un email valide`,
+		"phone":               `{{.Value}} n'est pas un téléphone valide`,
+		"ipv4":                `{{.Value}} n'est pas une ipv4 valide`,
+		"url":                 `{{.Value}} n'est pas une url valide`,
+		"uri":                 `{{.Value}} n'est pas une uri valide`,
+		"hostname":            `{{.Value}} n'est pas un nom d'hôte valide`,
+		"ipv6":                `{{.Value}} n'est pas une ipv6 valide`,
+		"uuid":                `{{.Value}} n'est pas un uuid valide`,
+		"datetime":            `{{.Value}} n'est pas une date et heure valide`,
+		"date":                `{{.Value}} n'est pas une date valide`,
+		"matches":             `{{.Value}} ne correspond pas à {{.Pattern}}`,
+		"notmatches":          `{{.Value}} correspond à {{.Pattern}}`,
+		"not":                 `{{.Value}} satisfait {{.Name}}`,
+		"startswith":          `{{.Value}} ne commence pas par {{.Needle}}`,
+		"endswith":            `{{.Value}} ne se termine pas par {{.Needle}}`,
+		"contains":            `{{.Value}} ne contient pas {{.Needle}}`,
+		"haskey":              `{{.Value}} n'a pas la clé {{.Key}}`,
+		"haskeys":             `{{.Value}} n'a pas les clés {{.Keys}}`,
+		"hasonlykeys":         `{{.Value}} a des clés inattendues {{.Keys}}`,
+		"hasvalue":            `{{.Value}} n'a pas la valeur {{.Element}}`,
+		"keymatches":          `{{.Value}} a des clés {{.Keys}} qui ne correspondent pas à {{.Pattern}}`,
+		"haskeyvalue":         `{{.Value}} n'a pas la clé {{.Key}} avec la valeur {{.Element}}`,
+	},
+	"de": {
+		"nil":                 `{{.Value}} ist nicht <nil>`,
+		"sametype":            `{{.Value}} und {{.Other}} sind nicht vom selben Typ`,
+		"equal":               `{{.Value}} ist nicht gleich {{.Other}}`,
+		"different":           `{{.Value}} ist nicht verschieden von {{.Other}}`,
+		"gt":                  `{{.Value}} ist nicht größer als {{.Other}}`,
+		"gte":                 `{{.Value}} ist nicht größer als oder gleich {{.Other}}`,
+		"lt":                  `{{.Value}} ist nicht kleiner als {{.Other}}`,
+		"lte":                 `{{.Value}} ist nicht kleiner als oder gleich {{.Other}}`,
+		"between":             `{{.Value}} liegt nicht zwischen {{.Min}} und {{.Max}}`,
+		"betweenexclude":      `{{.Value}} liegt nicht zwischen {{.Min}} und {{.Max}}, beide ausgeschlossen`,
+		"oneof":               `{{.Value}} ist keiner von {{.Options}}`,
+		"length":              `{{.Value}} hat keine Länge`,
+		"minlen":              `{{.Value}} ist kürzer als {{.Min}}`,
+		"maxlen":              `{{.Value}} ist länger als {{.Max}}`,
+		"required":            `der Wert ist leer`,
+		"missing":             `der Wert fehlt`,
+		"check":               `die Prüfung ist fehlgeschlagen`,
+		"any":                 `keine Alternative ist erfüllt: {{.Errors}}`,
+		"none":                `die Alternativen {{.Satisfied}} sind erfüllt`,
+		"mx":                  `{{.Value}} hat keine MX-Einträge`,
+		"string":              `{{.Value}} ist keine gültige Zeichenkette`,
+		"number":              `{{.Value}} ist keine gültige Zahl`,
+		"boolean":             `{{.Value}} ist keine gültige boolesche Zeichenkette`,
+		"truthy":              `{{.Value}} ist keine gültige wahre Zeichenkette`,
+		"falsy":               `{{.Value}} ist keine gültige falsche Zeichenkette`,
+		"integer":             `{{.Value}} ist keine gültige Ganzzahl`,
+		"integerbinary":       `{{.Value}} ist keine gültige Ganzzahl zur Basis 2`,
+		"integeroctal":        `{{.Value}} ist keine gültige Ganzzahl zur Basis 8`,
+		"integerhexadecimal":  `{{.Value}} ist keine gültige Ganzzahl zur Basis 16`,
+		"integerdecimal":      `{{.Value}} ist keine gültige Ganzzahl zur Basis 10`,
+		"unsigned":            `{{.Value}} ist keine gültige vorzeichenlose Ganzzahl`,
+		"unsignedbinary":      `{{.Value}} ist keine gültige vorzeichenlose Ganzzahl zur Basis 2`,
+		"unsignedoctal":       `{{.Value}} ist keine gültige vorzeichenlose Ganzzahl zur Basis 8`,
+		"unsignedhexadecimal": `{{.Value}} ist keine gültige vorzeichenlose Ganzzahl zur Basis 16`,
+		"unsigneddecimal":     `{{.Value}} ist keine gültige vorzeichenlose Ganzzahl zur Basis 10`,
+		"float":               `{{.Value}} ist keine gültige Gleitkommazahl`,
+		"base64":              `{{.Value}} ist kein gültiger base64-kodierter Wert`,
+		"alfanum":             `{{.Value}} ist nicht alphanumerisch`,
+		"digits":              `{{.Value}} enthält nicht nur Ziffern`,
+		"letters":             `{{.Value}} enthält nicht nur Buchstaben`,
+		"alfanumascii":        `{{.Value}} ist nicht alphanumerisches ASCII`,
+		"digitsascii":         `{{.Value}} enthält nicht nur ASCII-Ziffern`,
+		"lettersascii":        `{{.Value}} enthält nicht nur ASCII-Buchstaben`,
+		"ascii":               `{{.Value}} ist nicht ASCII`,
+		"printableascii":      `{{.Value}} ist nicht druckbares ASCII`,
+		"lowercase":           `{{.Value}} ist nicht in Kleinbuchstaben`,
+		"uppercase":           `{{.Value}} ist nicht in Großbuchstaben`,
+		"nowhitespace":        `{{.Value}} enthält Leerzeichen`,
+		"nocontrolchars":      `{{.Value}} enthält Steuerzeichen`,
+		"utf8":                `{{.Value}} ist keine gültige UTF-8-Zeichenkette`,
+		"hexadecimal":         `{{.Value}} ist nicht hexadezimal`,
+		"slug":                `{{.Value}} ist kein gültiger Slug`,
+		"onlyrunes":           `{{.Value}} enthält nicht erlaubte Zeichen`,
+		"email":               `{{.Value}} ist keine gültige E-Mail`,
+		"phone":               `{{.Value}} ist keine gültige Telefonnummer`,
+		"ipv4":                `{{.Value}} ist keine gültige IPv4`,
+		"url":                 `{{.Value}} ist keine gültige URL`,
+		"uri":                 `{{.Value}} ist keine gültige URI`,
+		"hostname":            `{{.Value}} ist kein gültiger Hostname`,
+		"ipv6":                `{{.Value}} ist keine gültige IPv6`,
+		"uuid":                `{{.Value}} ist keine gültige UUID`,
+		"datetime":            `{{.Value}} ist kein gültiger Zeitstempel`,
+		"date":                `{{.Value}} ist kein gültiges Datum`,
+		"matches":             `{{.Value}} entspricht nicht {{.Pattern}}`,
+		"notmatches":          `{{.Value}} entspricht {{.Pattern}}`,
+		"not":                 `{{.Value}} erfüllt {{.Name}}`,
+		"startswith":          `{{.Value}} beginnt nicht mit {{.Needle}}`,
+		"endswith":            `{{.Value}} endet nicht mit {{.Needle}}`,
+		"contains":            `{{.Value}} enthält nicht {{.Needle}}`,
+		"haskey":              `{{.Value}} hat den Schlüssel {{.Key}} nicht`,
+		"haskeys":             `{{.Value}} hat die Schlüssel {{.Keys}} nicht`,
+		"hasonlykeys":         `{{.Value}} hat unerwartete Schlüssel {{.Keys}}`,
+		"hasvalue":            `{{.Value}} hat den Wert {{.Element}} nicht`,
+		"keymatches":          `{{.Value}} hat Schlüssel {{.Keys}}, die nicht {{.Pattern}} entsprechen`,
+		"haskeyvalue":         `{{.Value}} hat den Schlüssel {{.Key}} mit dem Wert {{.Element}} nicht`,
+	},
+	"pt": {
+		"nil":                 `{{.Value}} não é <nil>`,
+		"sametype":            `{{.Value}} e {{.Other}} não são do mesmo tipo`,
+		"equal":               `{{.Value}} não é igual a {{.Other}}`,
+		"different":           `{{.Value}} não é diferente de {{.Other}}`,
+		"gt":                  `{{.Value}} não é maior que {{.Other}}`,
+		"gte":                 `{{.Value}} não é maior ou igual a {{.Other}}`,
+		"lt":                  `{{.Value}} não é menor que {{.Other}}`,
+		"lte":                 `{{.Value}} não é menor ou igual a {{.Other}}`,
+		"between":             `{{.Value}} não está entre {{.Min}} e {{.Max}}`,
+		"betweenexclude":      `{{.Value}} não está entre {{.Min}} e {{.Max}} ambos excluídos`,
+		"oneof":               `{{.Value}} não é um de {{.Options}}`,
+		"length":              `{{.Value}} não tem comprimento`,
+		"minlen":              `{{.Value}} é mais curto que {{.Min}}`,
+		"maxlen":              `{{.Value}} é mais longo que {{.Max}}`,
+		"required":            `o valor está vazio`,
+		"missing":             `o valor está ausente`,
+		"check":               `a verificação falhou`,
+		"any":                 `nenhuma alternativa é satisfeita: {{.Errors}}`,
+		"none":                `as alternativas {{.Satisfied}} são satisfeitas`,
+		"mx":                  `{{.Value}} não tem registros mx`,
+		"string":              `{{.Value}} não é uma string válida`,
+		"number":              `{{.Value}} não é um número válido`,
+		"boolean":             `{{.Value}} não é uma string booleana válida`,
+		"truthy":              `{{.Value}} não é uma string verdadeira válida`,
+		"falsy":               `{{.Value}} não é uma string falsa válida`,
+		"integer":             `{{.Value}} não é um inteiro válido`,
+		"integerbinary":       `{{.Value}} não é um inteiro válido na base 2`,
+		"integeroctal":        `{{.Value}} não é um inteiro válido na base 8`,
+		"integerhexadecimal":  `{{.Value}} não é um inteiro válido na base 16`,
+		"integerdecimal":      `{{.Value}} não é um inteiro válido na base 10`,
+		"unsigned":            `{{.Value}} não é um inteiro sem sinal válido`,
+		"unsignedbinary":      `{{.Value}} não é um inteiro sem sinal válido na base 2`,
+		"unsignedoctal":       `{{.Value}} não é um inteiro sem sinal válido na base 8`,
+		"unsignedhexadecimal": `{{.Value}} não é um inteiro sem sinal válido na base 16`,
+		"unsigneddecimal":     `{{.Value}} não é um inteiro sem sinal válido na base 10`,
+		"float":               `{{.Value}} não é um decimal válido`,
+		"base64":              `{{.Value}} não é um valor codificado em base64 válido`,
+		"alfanum":             `{{.Value}} não é alfanumérico`,
+		"digits":              `{{.Value}} não contém apenas dígitos`,
+		"letters":             `{{.Value}} não contém apenas letras`,
+		"alfanumascii":        `{{.Value}} não é alfanumérico ascii`,
+		"digitsascii":         `{{.Value}} não contém apenas dígitos ascii`,
+		"lettersascii":        `{{.Value}} não contém apenas letras ascii`,
+		"ascii":               `{{.Value}} não é ascii`,
+		"printableascii":      `{{.Value}} não é ascii imprimível`,
+		"lowercase":           `{{.Value}} não está em minúsculas`,
+		"uppercase":           `{{.Value}} não está em maiúsculas`,
+		"nowhitespace":        `{{.Value}} contém espaços em branco`,
+		"nocontrolchars":      `{{.Value}} contém caracteres de controle`,
+		"utf8":                `{{.Value}} não é uma string utf-8 válida`,
+		"hexadecimal":         `{{.Value}} não é hexadecimal`,
+		"slug":                `{{.Value}} não é um slug válido`,
+		"onlyrunes":           `{{.Value}} contém caracteres não permitidos`,
+		"email":               `{{.Value}} não é um email válido`,
+		"phone":               `{{.Value}} não é um telefone válido`,
+		"ipv4":                `{{.Value}} não é um ipv4 válido`,
+		"url":                 `{{.Value}} não é uma url válida`,
+		"uri":                 `{{.Value}} não é uma uri válida`,
+		"hostname":            `{{.Value}} não é um nome de host válido`,
+		"ipv6":                `{{.Value}} não é um ipv6 válido`,
+		"uuid":                `{{.Value}} não é um uuid válido`,
+		"datetime":            `{{.Value}} não é uma data e hora válida`,
+		"date":                `{{.Value}} não é uma data válida`,
+		"matches":             `{{.Value}} não corresponde a {{.Pattern}}`,
+		"notmatches":          `{{.Value}} corresponde a {{.Pattern}}`,
+		"not":                 `{{.Value}} satisfaz {{.Name}}`,
+		"startswith":          `{{.Value}} não começa com {{.Needle}}`,
+		"endswith":            `{{.Value}} não termina com {{.Needle}}`,
+		"contains":            `{{.Value}} não contém {{.Needle}}`,
+		"haskey":              `{{.Value}} não tem a chave {{.Key}}`,
+		"haskeys":             `{{.Value}} não tem as chaves {{.Keys}}`,
+		"hasonlykeys":         `{{.Value}} tem chaves inesperadas {{.Keys}}`,
+		"hasvalue":            `{{.Value}} não tem o valor {{.Element}}`,
+		"keymatches":          `{{.Value}} tem chaves {{.Keys}} que não correspondem a {{.Pattern}}`,
+		"haskeyvalue":         `{{.Value}} não tem a chave {{.Key}} com o valor {{.Element}}`,
+	},
+}
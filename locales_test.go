@@ -0,0 +1,72 @@
+package assertion
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBuiltinCatalog(t *testing.T) {
+	for _, locale := range []string{"es", "fr", "de", "pt"} {
+		for rule := range defaultMessages {
+			_, ok := builtinCatalog.Translate(locale, rule)
+			assert.True(t, ok, "%s has no translation for %s", locale, rule)
+		}
+	}
+}
+
+func TestWithLocale(t *testing.T) {
+	a := New(WithLocale("es"))
+
+	assert.False(t, a.GreaterThan(1, 5))
+	assert.False(t, a.ThatString("email", "foo").Email().Valid())
+	assert.False(t, a.GreaterThan(1, 5, "custom message"))
+
+	assert.EqualError(t, a.ErrorAt(0), "1 no es mayor que 5")
+	assert.EqualError(t, a.ErrorAt(1), "email: foo no es un email válido")
+	assert.EqualError(t, a.ErrorAt(2), "custom message")
+}
+
+func TestWithLocale_Regional(t *testing.T) {
+	a := New(WithLocale("pt_BR"))
+	a.LowerThan(5, 1)
+
+	assert.EqualError(t, a.ErrorAt(0), "5 não é menor que 1")
+}
+
+func TestWithLocale_Unknown(t *testing.T) {
+	a := New(WithLocale("xx"))
+	a.LowerThan(5, 1)
+
+	assert.EqualError(t, a.ErrorAt(0), "5 is not lower than 1")
+}
+
+func TestWithLocale_Any(t *testing.T) {
+	a := New(WithLocale("fr"))
+	a.Any([]CheckFunc{
+		func(a *Assertion) bool { return a.Ipv4("foo") },
+		func(a *Assertion) bool { return a.Email("foo") },
+	})
+
+	assert.EqualError(t, a.ErrorAt(0), "aucune alternative n'est satisfaite : foo n'est pas une ipv4 valide; foo n'est pas un email valide")
+}
+
+func TestWithTranslator(t *testing.T) {
+	a := New(WithLocale("es"), WithTranslator(Catalog{"es": {"gt": "{value} debe ser mayor que {other}"}}))
+	a.GreaterThan(1, 5)
+	a.LowerThan(5, 1)
+
+	assert.EqualError(t, a.ErrorAt(0), "1 debe ser mayor que 5")
+	assert.EqualError(t, a.ErrorAt(1), "5 no es menor que 1")
+}
+
+func TestError_Localize(t *testing.T) {
+	a := New()
+	a.That("age", 200).Between(0, 150)
+
+	var e *Error
+	assert.True(t, errors.As(a.ErrorAt(0), &e))
+	assert.Equal(t, "age: 200 liegt nicht zwischen 0 und 150", e.Localize("de"))
+	assert.Equal(t, "age: 200 is not between 0 and 150", e.Localize("en-US"))
+	assert.Equal(t, "age: 200 is not between 0 and 150", e.Error())
+}
@@ -2,9 +2,13 @@ package assertion
 
 import (
 	"fmt"
+	"net/netip"
+	"net/url"
 	"regexp"
 	"strings"
+	"time"
 	"unicode"
+	"unicode/utf8"
 )
 
 var (
@@ -22,44 +26,154 @@ var (
 	rexIPv4OrDomain  = fmt.Sprintf(`(?:\[%s\])|(?:%s)`, rexIPv4Octets, rexDomain)
 	rexEmail         = fmt.Sprintf(`^(?:%s)@(?:%s)$`, rexLocalPart, rexIPv4OrDomain)
 	rexIPv4          = fmt.Sprintf(`^%s$`, rexIPv4Octets)
+	rexHostname      = fmt.Sprintf(`^(?:%s)(?:\.(?:%s))*\.?$`, rexSubdomain, rexSubdomain)
 	rexE164          = `^\+?[1-9]\d{1,14}$`
+	rexSlug          = `^[a-z0-9]+(?:-[a-z0-9]+)*$`
+	rexUUID          = `^[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}$`
 )
 
 var (
-	regexpEmail = regexp.MustCompile(rexEmail)
-	regexpIpv4  = regexp.MustCompile(rexIPv4)
-	regexpE164  = regexp.MustCompile(rexE164)
+	regexpEmail    = regexp.MustCompile(rexEmail)
+	regexpIpv4     = regexp.MustCompile(rexIPv4)
+	regexpE164     = regexp.MustCompile(rexE164)
+	regexpSlug     = regexp.MustCompile(rexSlug)
+	regexpHostname = regexp.MustCompile(rexHostname)
+	regexpUUID     = regexp.MustCompile(rexUUID)
 )
 
 // Alfanum returns true if a given value only contains alfa-numeric runes.
 func (a *Assertion) Alfanum(value string, msgArgs ...interface{}) bool {
-	for _, r := range value {
-		if !unicode.IsLetter(r) && !unicode.IsNumber(r) {
-			a.addErrorMsg(fmt.Sprintf(errMsgNot, value, "alfa-numeric"), msgArgs...)
-			return false
-		}
-	}
-
-	return true
+	return a.eval(func() bool {
+		return a.onlyRunes(value, isAlfanum, "alfanum", msgArgs...)
+	})
 }
 
 // Digits returns true if a given value only contains digit runes.
 func (a *Assertion) Digits(value string, msgArgs ...interface{}) bool {
-	for _, r := range value {
-		if !unicode.IsNumber(r) {
-			a.addErrorMsg(fmt.Sprintf(errMsgNot, value, "only digits"), msgArgs...)
-			return false
-		}
-	}
-
-	return true
+	return a.eval(func() bool {
+		return a.onlyRunes(value, unicode.IsNumber, "digits", msgArgs...)
+	})
 }
 
 // Letters returns true if a given value only contains letter runes.
 func (a *Assertion) Letters(value string, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		return a.onlyRunes(value, unicode.IsLetter, "letters", msgArgs...)
+	})
+}
+
+// AlfanumASCII returns true if a given value only contains ASCII letters and digits.
+func (a *Assertion) AlfanumASCII(value string, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		return a.onlyRunes(value, isAlfanumASCII, "alfanumascii", msgArgs...)
+	})
+}
+
+// DigitsASCII returns true if a given value only contains ASCII digits (0-9).
+func (a *Assertion) DigitsASCII(value string, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		return a.onlyRunes(value, isDigitASCII, "digitsascii", msgArgs...)
+	})
+}
+
+// LettersASCII returns true if a given value only contains ASCII letters (a-z, A-Z).
+func (a *Assertion) LettersASCII(value string, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		return a.onlyRunes(value, isLetterASCII, "lettersascii", msgArgs...)
+	})
+}
+
+// ASCII returns true if a given value only contains ASCII runes.
+func (a *Assertion) ASCII(value string, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		return a.onlyRunes(value, isASCII, "ascii", msgArgs...)
+	})
+}
+
+// PrintableASCII returns true if a given value only contains printable ASCII
+// runes, that is from space (0x20) to tilde (0x7E).
+func (a *Assertion) PrintableASCII(value string, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		return a.onlyRunes(value, isPrintableASCII, "printableascii", msgArgs...)
+	})
+}
+
+// Lowercase returns true if a given value has no upper or title case runes.
+func (a *Assertion) Lowercase(value string, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		return a.onlyRunes(value, isNotUpper, "lowercase", msgArgs...)
+	})
+}
+
+// Uppercase returns true if a given value has no lower or title case runes.
+func (a *Assertion) Uppercase(value string, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		return a.onlyRunes(value, isNotLower, "uppercase", msgArgs...)
+	})
+}
+
+// NoWhitespace returns true if a given value has no white space runes.
+func (a *Assertion) NoWhitespace(value string, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		return a.onlyRunes(value, isNotSpace, "nowhitespace", msgArgs...)
+	})
+}
+
+// NoControlChars returns true if a given value has no control runes.
+func (a *Assertion) NoControlChars(value string, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		return a.onlyRunes(value, isNotControl, "nocontrolchars", msgArgs...)
+	})
+}
+
+// ValidUTF8 returns true if a given value is entirely made of valid UTF-8 encoded runes.
+func (a *Assertion) ValidUTF8(value string, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		if utf8.ValidString(value) {
+			return true
+		}
+
+		a.fail("utf8", value, nil, msgArgs...)
+		return false
+	})
+}
+
+// Hexadecimal returns true if a given value only contains hexadecimal digits.
+func (a *Assertion) Hexadecimal(value string, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		return a.onlyRunes(value, isHexDigit, "hexadecimal", msgArgs...)
+	})
+}
+
+// Slug returns true if a given value is a valid slug: lowercase ASCII letters
+// and digits in groups separated by single hyphens.
+func (a *Assertion) Slug(value string, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		if regexpSlug.MatchString(value) {
+			return true
+		}
+
+		a.fail("slug", value, nil, msgArgs...)
+		return false
+	})
+}
+
+// OnlyRunes returns true if every rune of a given value belongs to some of the
+// given unicode range tables, e.g. []*unicode.RangeTable{unicode.Latin, unicode.Digit}.
+func (a *Assertion) OnlyRunes(value string, tables []*unicode.RangeTable, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		return a.onlyRunes(value, func(r rune) bool {
+			return unicode.IsOneOf(tables, r)
+		}, "onlyrunes", msgArgs...)
+	})
+}
+
+// onlyRunes returns true if every rune of a given value satisfies the given
+// function. Otherwise it adds an error of the given rule
+func (a *Assertion) onlyRunes(value string, fn func(rune) bool, rule string, msgArgs ...interface{}) bool {
 	for _, r := range value {
-		if !unicode.IsLetter(r) {
-			a.addErrorMsg(fmt.Sprintf(errMsgNot, value, "only letters"), msgArgs...)
+		if !fn(r) {
+			a.fail(rule, value, nil, msgArgs...)
 			return false
 		}
 	}
@@ -67,45 +181,237 @@ func (a *Assertion) Letters(value string, msgArgs ...interface{}) bool {
 	return true
 }
 
+// isAlfanum returns true if a given rune is a unicode letter or number
+func isAlfanum(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+// isAlfanumASCII returns true if a given rune is an ASCII letter or digit
+func isAlfanumASCII(r rune) bool {
+	return isLetterASCII(r) || isDigitASCII(r)
+}
+
+// isDigitASCII returns true if a given rune is an ASCII digit
+func isDigitASCII(r rune) bool {
+	return '0' <= r && r <= '9'
+}
+
+// isLetterASCII returns true if a given rune is an ASCII letter
+func isLetterASCII(r rune) bool {
+	return ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z')
+}
+
+// isASCII returns true if a given rune is an ASCII rune
+func isASCII(r rune) bool {
+	return r <= unicode.MaxASCII
+}
+
+// isPrintableASCII returns true if a given rune is a printable ASCII rune
+func isPrintableASCII(r rune) bool {
+	return ' ' <= r && r <= '~'
+}
+
+// isHexDigit returns true if a given rune is an hexadecimal digit
+func isHexDigit(r rune) bool {
+	return isDigitASCII(r) || ('a' <= r && r <= 'f') || ('A' <= r && r <= 'F')
+}
+
+// isNotUpper returns true if a given rune is neither upper nor title case
+func isNotUpper(r rune) bool {
+	return !unicode.IsUpper(r) && !unicode.IsTitle(r)
+}
+
+// isNotLower returns true if a given rune is neither lower nor title case
+func isNotLower(r rune) bool {
+	return !unicode.IsLower(r) && !unicode.IsTitle(r)
+}
+
+// isNotSpace returns true if a given rune is not a white space
+func isNotSpace(r rune) bool {
+	return !unicode.IsSpace(r)
+}
+
+// isNotControl returns true if a given rune is not a control rune
+func isNotControl(r rune) bool {
+	return !unicode.IsControl(r)
+}
+
 // Email returns true if a given value is a valid email format. It allows local
 // portion to be quoted text and ipv4 for the domain portion (between square brackets).
 func (a *Assertion) Email(value string, msgArgs ...interface{}) bool {
-	if !regexpEmail.MatchString(value) {
-		a.addErrorMsg(fmt.Sprintf(errMsgNotValid, value, "email"), msgArgs...)
-		return false
-	}
+	return a.eval(func() bool {
+		if !regexpEmail.MatchString(value) {
+			a.fail("email", value, nil, msgArgs...)
+			return false
+		}
 
-	splits := strings.Split(value, "@")
-	domain := splits[len(splits)-1]
-	if len(domain) > 255 {
-		a.addErrorMsg(fmt.Sprintf(errMsgNotValid, value, "email"), msgArgs...)
-		return false
-	}
+		splits := strings.Split(value, "@")
+		domain := splits[len(splits)-1]
+		if len(domain) > 255 {
+			a.fail("email", value, nil, msgArgs...)
+			return false
+		}
 
-	if regexpIpv4.MatchString(domain) {
-		a.addErrorMsg(fmt.Sprintf(errMsgNotValid, value, "email"), msgArgs...)
-		return false
-	}
+		if regexpIpv4.MatchString(domain) {
+			a.fail("email", value, nil, msgArgs...)
+			return false
+		}
 
-	return true
+		return true
+	})
 }
 
 // Phone returns true if a given value ia a valid e164 phone number
 func (a *Assertion) Phone(value string, msgArgs ...interface{}) bool {
-	if !regexpE164.MatchString(value) {
-		a.addErrorMsg(fmt.Sprintf(errMsgNotValid, value, "phone"), msgArgs...)
-		return false
-	}
+	return a.eval(func() bool {
+		if !regexpE164.MatchString(value) {
+			a.fail("phone", value, nil, msgArgs...)
+			return false
+		}
 
-	return true
+		return true
+	})
 }
 
 // Ipv4 returns true if a given value is a valid ipv4 string
 func (a *Assertion) Ipv4(value string, msgArgs ...interface{}) bool {
-	if regexpIpv4.MatchString(value) {
+	return a.eval(func() bool {
+		if regexpIpv4.MatchString(value) {
+			return true
+		}
+
+		a.fail("ipv4", value, nil, msgArgs...)
+		return false
+	})
+}
+
+// Ipv6 returns true if a given value is a valid ipv6 string, without zone
+func (a *Assertion) Ipv6(value string, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		if ip, err := netip.ParseAddr(value); err == nil && ip.Is6() && ip.Zone() == "" {
+			return true
+		}
+
+		a.fail("ipv6", value, nil, msgArgs...)
+		return false
+	})
+}
+
+// UUID returns true if a given value is a valid uuid in its canonical textual
+// form, e.g. 123e4567-e89b-12d3-a456-426614174000, in any case
+func (a *Assertion) UUID(value string, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		if regexpUUID.MatchString(value) {
+			return true
+		}
+
+		a.fail("uuid", value, nil, msgArgs...)
+		return false
+	})
+}
+
+// DateTime returns true if a given value is a valid RFC 3339 date and time,
+// e.g. 2024-01-31T23:59:59Z or 2024-01-31T23:59:59.5+01:00
+func (a *Assertion) DateTime(value string, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		if _, err := time.Parse(time.RFC3339Nano, value); err == nil {
+			return true
+		}
+
+		a.fail("datetime", value, nil, msgArgs...)
+		return false
+	})
+}
+
+// Date returns true if a given value is a valid RFC 3339 full date, e.g.
+// 2024-01-31
+func (a *Assertion) Date(value string, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		if _, err := time.Parse(time.DateOnly, value); err == nil {
+			return true
+		}
+
+		a.fail("date", value, nil, msgArgs...)
+		return false
+	})
+}
+
+// Hostname returns true if a given value is a valid RFC 1123 host name, that is
+// dot separated labels of letters, digits and hyphens, e.g. localhost or
+// db-1.example.com, up to 253 characters
+func (a *Assertion) Hostname(value string, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		if len(strings.TrimSuffix(value, ".")) <= 253 && regexpHostname.MatchString(value) {
+			return true
+		}
+
+		a.fail("hostname", value, nil, msgArgs...)
+		return false
+	})
+}
+
+// URL returns true if a given value is a valid absolute url, with both scheme
+// and host, e.g. https://example.com/path or postgres://user@db:5432/name
+func (a *Assertion) URL(value string, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		if u, err := url.Parse(value); err == nil && u.Scheme != "" && u.Host != "" {
+			return true
+		}
+
+		a.fail("url", value, nil, msgArgs...)
+		return false
+	})
+}
+
+// URI returns true if a given value is a valid absolute uri, RFC 3986, with a
+// scheme but not necessarily a host, e.g. urn:isbn:0451450523 or mailto:a@b.c
+func (a *Assertion) URI(value string, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		if u, err := url.Parse(value); err == nil && u.Scheme != "" {
+			return true
+		}
+
+		a.fail("uri", value, nil, msgArgs...)
+		return false
+	})
+}
+
+// Matches returns true if a given value matches the given regular expression
+// pattern, which may be a string or a compiled *regexp.Regexp. String patterns
+// are compiled once and cached. An invalid pattern records an error wrapping
+// ErrInvalidPattern instead of panicking
+func (a *Assertion) Matches(value string, pattern interface{}, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		rex, err := compilePattern(pattern)
+		if err != nil {
+			a.addError(err)
+			return false
+		}
+
+		if !rex.MatchString(value) {
+			a.fail("matches", value, map[string]interface{}{"Pattern": rex}, msgArgs...)
+			return false
+		}
+
 		return true
-	}
+	})
+}
+
+// NotMatches returns true if a given value does not match the given regular
+// expression pattern. Patterns are handled as in Matches
+func (a *Assertion) NotMatches(value string, pattern interface{}, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		rex, err := compilePattern(pattern)
+		if err != nil {
+			a.addError(err)
+			return false
+		}
 
-	a.addErrorMsg(fmt.Sprintf(errMsgNotValid, value, "ipv4"), msgArgs...)
-	return false
+		if rex.MatchString(value) {
+			a.fail("notmatches", value, map[string]interface{}{"Pattern": rex}, msgArgs...)
+			return false
+		}
+
+		return true
+	})
 }
@@ -1,8 +1,12 @@
 package assertion
 
 import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"regexp"
 	"strings"
 	"testing"
+	"unicode"
 )
 
 func TestAssertion_Alfanum_ReturnsTrue(t *testing.T) {
@@ -147,3 +151,307 @@ func TestAssertion_Ipv4_ReturnsFalse(t *testing.T) {
 
 	assertAllReturnsFalse(t, data)
 }
+
+func TestAssertion_Ipv6_ReturnsTrue(t *testing.T) {
+	data := []MethodDataOK{
+		{"Ipv6", []interface{}{"::1"}},
+		{"Ipv6", []interface{}{"2001:db8::8a2e:370:7334"}},
+		{"Ipv6", []interface{}{"::ffff:192.0.2.1"}},
+	}
+
+	assertAllReturnsTrue(t, data)
+}
+
+func TestAssertion_Ipv6_ReturnsFalse(t *testing.T) {
+	data := []MethodDataKO{
+		{"Ipv6", []interface{}{"127.0.0.1"}, "127.0.0.1 is not a valid ipv6"},
+		{"Ipv6", []interface{}{"fe80::1%eth0"}, "fe80::1%eth0 is not a valid ipv6"},
+		{"Ipv6", []interface{}{"2001:db8:::1"}, "2001:db8:::1 is not a valid ipv6"},
+	}
+
+	assertAllReturnsFalse(t, data)
+}
+
+func TestAssertion_UUID_ReturnsTrue(t *testing.T) {
+	data := []MethodDataOK{
+		{"UUID", []interface{}{"123e4567-e89b-12d3-a456-426614174000"}},
+		{"UUID", []interface{}{"123E4567-E89B-12D3-A456-426614174000"}},
+	}
+
+	assertAllReturnsTrue(t, data)
+}
+
+func TestAssertion_UUID_ReturnsFalse(t *testing.T) {
+	data := []MethodDataKO{
+		{"UUID", []interface{}{"123e4567e89b12d3a456426614174000"}, "123e4567e89b12d3a456426614174000 is not a valid uuid"},
+		{"UUID", []interface{}{"123e4567-e89b-12d3-a456-42661417400g"}, "123e4567-e89b-12d3-a456-42661417400g is not a valid uuid"},
+	}
+
+	assertAllReturnsFalse(t, data)
+}
+
+func TestAssertion_DateTime_ReturnsTrue(t *testing.T) {
+	data := []MethodDataOK{
+		{"DateTime", []interface{}{"2024-01-31T23:59:59Z"}},
+		{"DateTime", []interface{}{"2024-01-31T23:59:59.5+01:00"}},
+		{"Date", []interface{}{"2024-02-29"}},
+	}
+
+	assertAllReturnsTrue(t, data)
+}
+
+func TestAssertion_DateTime_ReturnsFalse(t *testing.T) {
+	data := []MethodDataKO{
+		{"DateTime", []interface{}{"2024-01-31"}, "2024-01-31 is not a valid date-time"},
+		{"DateTime", []interface{}{"2024-01-31 23:59:59Z"}, "2024-01-31 23:59:59Z is not a valid date-time"},
+		{"Date", []interface{}{"2023-02-29"}, "2023-02-29 is not a valid date"},
+		{"Date", []interface{}{"31/01/2024"}, "31/01/2024 is not a valid date"},
+	}
+
+	assertAllReturnsFalse(t, data)
+}
+
+func TestAssertion_Hostname_ReturnsTrue(t *testing.T) {
+	data := []MethodDataOK{
+		{"Hostname", []interface{}{"localhost"}},
+		{"Hostname", []interface{}{"db-1.example.com"}},
+		{"Hostname", []interface{}{"example.com."}},
+		{"Hostname", []interface{}{"127.0.0.1"}},
+	}
+
+	assertAllReturnsTrue(t, data)
+}
+
+func TestAssertion_Hostname_ReturnsFalse(t *testing.T) {
+	data := []MethodDataKO{
+		{"Hostname", []interface{}{""}, " is not a valid hostname"},
+		{"Hostname", []interface{}{"foo_bar"}, "foo_bar is not a valid hostname"},
+		{"Hostname", []interface{}{"-foo.com"}, "-foo.com is not a valid hostname"},
+		{"Hostname", []interface{}{"foo..com"}, "foo..com is not a valid hostname"},
+		{"Hostname", []interface{}{"example.com:80"}, "example.com:80 is not a valid hostname"},
+	}
+
+	assertAllReturnsFalse(t, data)
+}
+
+func TestAssertion_URL_ReturnsTrue(t *testing.T) {
+	data := []MethodDataOK{
+		{"URL", []interface{}{"https://example.com"}},
+		{"URL", []interface{}{"http://127.0.0.1:8080/path?q=1"}},
+		{"URL", []interface{}{"postgres://user:pass@db:5432/name"}},
+	}
+
+	assertAllReturnsTrue(t, data)
+}
+
+func TestAssertion_URI_ReturnsTrue(t *testing.T) {
+	data := []MethodDataOK{
+		{"URI", []interface{}{"https://example.com/path"}},
+		{"URI", []interface{}{"urn:isbn:0451450523"}},
+		{"URI", []interface{}{"mailto:a@b.c"}},
+		{"URI", []interface{}{"file:///etc/hosts"}},
+	}
+
+	assertAllReturnsTrue(t, data)
+}
+
+func TestAssertion_URI_ReturnsFalse(t *testing.T) {
+	data := []MethodDataKO{
+		{"URI", []interface{}{"example.com"}, "example.com is not a valid uri"},
+		{"URI", []interface{}{"/path"}, "/path is not a valid uri"},
+		{"URI", []interface{}{"http://[::1"}, "http://[::1 is not a valid uri"},
+	}
+
+	assertAllReturnsFalse(t, data)
+}
+
+func TestAssertion_URL_ReturnsFalse(t *testing.T) {
+	data := []MethodDataKO{
+		{"URL", []interface{}{"example.com"}, "example.com is not a valid url"},
+		{"URL", []interface{}{"/path"}, "/path is not a valid url"},
+		{"URL", []interface{}{"mailto:foo@example.com"}, "mailto:foo@example.com is not a valid url"},
+		{"URL", []interface{}{"http://[::1"}, "http://[::1 is not a valid url"},
+	}
+
+	assertAllReturnsFalse(t, data)
+}
+
+func TestAssertion_Matches_ReturnsTrue(t *testing.T) {
+	data := []MethodDataOK{
+		{"Matches", []interface{}{"abc123", `^[a-z]+\d+$`}},
+		{"Matches", []interface{}{"abc123", regexp.MustCompile(`\d{3}`)}},
+		{"Matches", []interface{}{"", `^$`}},
+	}
+
+	assertAllReturnsTrue(t, data)
+}
+
+func TestAssertion_Matches_ReturnsFalse(t *testing.T) {
+	data := []MethodDataKO{
+		{"Matches", []interface{}{"abc", `^\d+$`}, `abc does not match ^\d+$`},
+		{"Matches", []interface{}{"abc", regexp.MustCompile(`^\d+$`)}, `abc does not match ^\d+$`},
+	}
+
+	assertAllReturnsFalse(t, data)
+}
+
+func TestAssertion_NotMatches_ReturnsTrue(t *testing.T) {
+	data := []MethodDataOK{
+		{"NotMatches", []interface{}{"abc", `^\d+$`}},
+		{"NotMatches", []interface{}{"abc", regexp.MustCompile(`^\d+$`)}},
+	}
+
+	assertAllReturnsTrue(t, data)
+}
+
+func TestAssertion_NotMatches_ReturnsFalse(t *testing.T) {
+	data := []MethodDataKO{
+		{"NotMatches", []interface{}{"123", `^\d+$`}, `123 matches ^\d+$`},
+		{"NotMatches", []interface{}{"123", regexp.MustCompile(`^\d+$`)}, `123 matches ^\d+$`},
+	}
+
+	assertAllReturnsFalse(t, data)
+}
+
+func TestAssertion_Matches_InvalidPattern(t *testing.T) {
+	for _, pattern := range []interface{}{`(abc`, 123, (*regexp.Regexp)(nil)} {
+		a := New()
+		assert.False(t, a.Matches("abc", pattern, "custom error"))
+		assert.False(t, a.NotMatches("abc", pattern))
+		assert.Equal(t, 2, a.CountErrors())
+		assert.True(t, errors.Is(a.ErrorAt(0), ErrInvalidPattern))
+		assert.True(t, errors.Is(a.ErrorAt(1), ErrInvalidPattern))
+	}
+}
+
+func TestAssertion_AlfanumASCII(t *testing.T) {
+	assertAllReturnsTrue(t, []MethodDataOK{
+		{"AlfanumASCII", []interface{}{"abcXYZ019"}},
+	})
+	assertAllReturnsFalse(t, []MethodDataKO{
+		{"AlfanumASCII", []interface{}{"España"}, "España is not alfa-numeric ascii"},
+		{"AlfanumASCII", []interface{}{"abc 123"}, "abc 123 is not alfa-numeric ascii"},
+	})
+}
+
+func TestAssertion_DigitsASCII(t *testing.T) {
+	assertAllReturnsTrue(t, []MethodDataOK{
+		{"DigitsASCII", []interface{}{"0123456789"}},
+	})
+	assertAllReturnsFalse(t, []MethodDataKO{
+		{"DigitsASCII", []interface{}{"12½"}, "12½ is not only ascii digits"},
+		{"DigitsASCII", []interface{}{"١٢٣"}, "١٢٣ is not only ascii digits"},
+	})
+}
+
+func TestAssertion_LettersASCII(t *testing.T) {
+	assertAllReturnsTrue(t, []MethodDataOK{
+		{"LettersASCII", []interface{}{"abcDEF"}},
+	})
+	assertAllReturnsFalse(t, []MethodDataKO{
+		{"LettersASCII", []interface{}{"abcá"}, "abcá is not only ascii letters"},
+	})
+}
+
+func TestAssertion_ASCII(t *testing.T) {
+	assertAllReturnsTrue(t, []MethodDataOK{
+		{"ASCII", []interface{}{"abc 123\t~\x00"}},
+	})
+	assertAllReturnsFalse(t, []MethodDataKO{
+		{"ASCII", []interface{}{"niño"}, "niño is not ascii"},
+	})
+}
+
+func TestAssertion_PrintableASCII(t *testing.T) {
+	assertAllReturnsTrue(t, []MethodDataOK{
+		{"PrintableASCII", []interface{}{"abc 123 !~"}},
+	})
+	assertAllReturnsFalse(t, []MethodDataKO{
+		{"PrintableASCII", []interface{}{"abc\t"}, "abc\t is not printable ascii"},
+		{"PrintableASCII", []interface{}{"niño"}, "niño is not printable ascii"},
+	})
+}
+
+func TestAssertion_Lowercase(t *testing.T) {
+	assertAllReturnsTrue(t, []MethodDataOK{
+		{"Lowercase", []interface{}{"hello world 123 ß"}},
+	})
+	assertAllReturnsFalse(t, []MethodDataKO{
+		{"Lowercase", []interface{}{"Hello"}, "Hello is not lowercase"},
+		{"Lowercase", []interface{}{"ǅ"}, "ǅ is not lowercase"},
+	})
+}
+
+func TestAssertion_Uppercase(t *testing.T) {
+	assertAllReturnsTrue(t, []MethodDataOK{
+		{"Uppercase", []interface{}{"HELLO WORLD 123 Ñ"}},
+	})
+	assertAllReturnsFalse(t, []MethodDataKO{
+		{"Uppercase", []interface{}{"HELLo"}, "HELLo is not uppercase"},
+	})
+}
+
+func TestAssertion_NoWhitespace(t *testing.T) {
+	assertAllReturnsTrue(t, []MethodDataOK{
+		{"NoWhitespace", []interface{}{"hello-world"}},
+	})
+	assertAllReturnsFalse(t, []MethodDataKO{
+		{"NoWhitespace", []interface{}{"hello world"}, "hello world is not free of whitespaces"},
+		{"NoWhitespace", []interface{}{"hello\u00a0world"}, "hello\u00a0world is not free of whitespaces"},
+	})
+}
+
+func TestAssertion_NoControlChars(t *testing.T) {
+	assertAllReturnsTrue(t, []MethodDataOK{
+		{"NoControlChars", []interface{}{"hello world!"}},
+	})
+	assertAllReturnsFalse(t, []MethodDataKO{
+		{"NoControlChars", []interface{}{"hello\nworld"}, "hello\nworld is not free of control characters"},
+		{"NoControlChars", []interface{}{"\x00"}, "\x00 is not free of control characters"},
+	})
+}
+
+func TestAssertion_ValidUTF8(t *testing.T) {
+	assertAllReturnsTrue(t, []MethodDataOK{
+		{"ValidUTF8", []interface{}{"España ✓"}},
+	})
+	assertAllReturnsFalse(t, []MethodDataKO{
+		{"ValidUTF8", []interface{}{"a\xffb"}, "a\xffb is not a valid utf-8 string"},
+	})
+}
+
+func TestAssertion_Hexadecimal(t *testing.T) {
+	assertAllReturnsTrue(t, []MethodDataOK{
+		{"Hexadecimal", []interface{}{"0123456789abcdefABCDEF"}},
+	})
+	assertAllReturnsFalse(t, []MethodDataKO{
+		{"Hexadecimal", []interface{}{"0x1F"}, "0x1F is not hexadecimal"},
+		{"Hexadecimal", []interface{}{"abg"}, "abg is not hexadecimal"},
+	})
+}
+
+func TestAssertion_Slug(t *testing.T) {
+	assertAllReturnsTrue(t, []MethodDataOK{
+		{"Slug", []interface{}{"hello-world-2"}},
+		{"Slug", []interface{}{"hello"}},
+	})
+	assertAllReturnsFalse(t, []MethodDataKO{
+		{"Slug", []interface{}{""}, " is not a valid slug"},
+		{"Slug", []interface{}{"Hello-world"}, "Hello-world is not a valid slug"},
+		{"Slug", []interface{}{"hello--world"}, "hello--world is not a valid slug"},
+		{"Slug", []interface{}{"-hello"}, "-hello is not a valid slug"},
+		{"Slug", []interface{}{"hello_world"}, "hello_world is not a valid slug"},
+	})
+}
+
+func TestAssertion_OnlyRunes(t *testing.T) {
+	latinDigits := []*unicode.RangeTable{unicode.Latin, unicode.Digit}
+
+	assertAllReturnsTrue(t, []MethodDataOK{
+		{"OnlyRunes", []interface{}{"España123", latinDigits}},
+	})
+	assertAllReturnsFalse(t, []MethodDataKO{
+		{"OnlyRunes", []interface{}{"España 123", latinDigits}, "España 123 is not only allowed runes"},
+		{"OnlyRunes", []interface{}{"Ελλάδα", latinDigits}, "Ελλάδα is not only allowed runes"},
+	})
+}
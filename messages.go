@@ -0,0 +1,214 @@
+package assertion
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// defaultMessages are the default message templates by rule. Templates reference
+// the asserted value as {{.Value}}, the field name as {{.Field}} and the rule
+// parameters by name, e.g. {{.Min}}. The short form {value}, {field} or {min}
+// is accepted too
+var defaultMessages = map[string]string{
+	"nil":                 `{{.Value}} is not <nil>`,
+	"sametype":            `{{.Value}} and {{.Other}} are not of the same type`,
+	"equal":               `{{.Value}} is not equal {{.Other}}`,
+	"different":           `{{.Value}} is not different {{.Other}}`,
+	"gt":                  `{{.Value}} is not greater than {{.Other}}`,
+	"gte":                 `{{.Value}} is not greater than or equal {{.Other}}`,
+	"lt":                  `{{.Value}} is not lower than {{.Other}}`,
+	"lte":                 `{{.Value}} is not lower than or equal {{.Other}}`,
+	"between":             `{{.Value}} is not between {{.Min}} and {{.Max}}`,
+	"betweenexclude":      `{{.Value}} is not between {{.Min}} and {{.Max}} both excluded`,
+	"oneof":               `{{.Value}} is not one of {{.Options}}`,
+	"length":              `{{.Value}} has no length`,
+	"minlen":              `{{.Value}} is shorter than {{.Min}}`,
+	"maxlen":              `{{.Value}} is longer than {{.Max}}`,
+	"required":            `value is empty`,
+	"missing":             `value is missing`,
+	"check":               `check failed`,
+	"any":                 `no alternative is satisfied: {{.Errors}}`,
+	"none":                `alternatives {{.Satisfied}} are satisfied`,
+	"mx":                  `{{.Value}} has no mx records`,
+	"string":              `{{.Value}} is not a valid string`,
+	"number":              `{{.Value}} is not a valid number`,
+	"boolean":             `{{.Value}} is not a valid boolean string`,
+	"truthy":              `{{.Value}} is not a valid truthy string`,
+	"falsy":               `{{.Value}} is not a valid falsy string`,
+	"integer":             `{{.Value}} is not a valid integer`,
+	"integerbinary":       `{{.Value}} is not a valid base-2 integer`,
+	"integeroctal":        `{{.Value}} is not a valid base-8 integer`,
+	"integerhexadecimal":  `{{.Value}} is not a valid base-16 integer`,
+	"integerdecimal":      `{{.Value}} is not a valid base-10 integer`,
+	"unsigned":            `{{.Value}} is not a valid unsigned integer`,
+	"unsignedbinary":      `{{.Value}} is not a valid base-2 unsigned integer`,
+	"unsignedoctal":       `{{.Value}} is not a valid base-8 unsigned integer`,
+	"unsignedhexadecimal": `{{.Value}} is not a valid base-16 unsigned integer`,
+	"unsigneddecimal":     `{{.Value}} is not a valid base-10 unsigned integer`,
+	"float":               `{{.Value}} is not a valid float`,
+	"base64":              `{{.Value}} is not a valid base64 encoded value`,
+	"alfanum":             `{{.Value}} is not alfa-numeric`,
+	"digits":              `{{.Value}} is not only digits`,
+	"letters":             `{{.Value}} is not only letters`,
+	"alfanumascii":        `{{.Value}} is not alfa-numeric ascii`,
+	"digitsascii":         `{{.Value}} is not only ascii digits`,
+	"lettersascii":        `{{.Value}} is not only ascii letters`,
+	"ascii":               `{{.Value}} is not ascii`,
+	"printableascii":      `{{.Value}} is not printable ascii`,
+	"lowercase":           `{{.Value}} is not lowercase`,
+	"uppercase":           `{{.Value}} is not uppercase`,
+	"nowhitespace":        `{{.Value}} is not free of whitespaces`,
+	"nocontrolchars":      `{{.Value}} is not free of control characters`,
+	"utf8":                `{{.Value}} is not a valid utf-8 string`,
+	"hexadecimal":         `{{.Value}} is not hexadecimal`,
+	"slug":                `{{.Value}} is not a valid slug`,
+	"onlyrunes":           `{{.Value}} is not only allowed runes`,
+	"email":               `{{.Value}} is not a valid email`,
+	"phone":               `{{.Value}} is not a valid phone`,
+	"ipv4":                `{{.Value}} is not a valid ipv4`,
+	"url":                 `{{.Value}} is not a valid url`,
+	"uri":                 `{{.Value}} is not a valid uri`,
+	"hostname":            `{{.Value}} is not a valid hostname`,
+	"ipv6":                `{{.Value}} is not a valid ipv6`,
+	"uuid":                `{{.Value}} is not a valid uuid`,
+	"datetime":            `{{.Value}} is not a valid date-time`,
+	"date":                `{{.Value}} is not a valid date`,
+	"matches":             `{{.Value}} does not match {{.Pattern}}`,
+	"notmatches":          `{{.Value}} matches {{.Pattern}}`,
+	"not":                 `{{.Value}} satisfies {{.Name}}`,
+	"startswith":          `{{.Value}} does not start with {{.Needle}}`,
+	"endswith":            `{{.Value}} does not end with {{.Needle}}`,
+	"contains":            `{{.Value}} does not contain {{.Needle}}`,
+	"haskey":              `{{.Value}} has not the key {{.Key}}`,
+	"haskeys":             `{{.Value}} has not the keys {{.Keys}}`,
+	"hasonlykeys":         `{{.Value}} has unexpected keys {{.Keys}}`,
+	"hasvalue":            `{{.Value}} has not the value {{.Element}}`,
+	"keymatches":          `{{.Value}} has keys {{.Keys}} not matching {{.Pattern}}`,
+	"haskeyvalue":         `{{.Value}} has not the key {{.Key}} with value {{.Element}}`,
+}
+
+// errMsgUnknownTemplate is the template of rules without a message template
+const errMsgUnknownTemplate = `{{.Value}} does not satisfy {{.Rule}}`
+
+var (
+	messagesMu sync.RWMutex
+	messages   = make(map[string]string)
+
+	// registeredCatalog holds the templates added with RegisterMessages
+	registeredCatalog = make(Catalog)
+)
+
+// rexPlaceholder matches the {{.Name}} and {name} template placeholders
+var rexPlaceholder = regexp.MustCompile(`\{\{\s*\.([A-Za-z_]\w*)\s*\}\}|\{([A-Za-z_]\w*)\}`)
+
+// SetMessages replaces the default message templates of the given rules, e.g.
+// SetMessages(map[string]string{"email": "{field} must be an email address"}).
+// An empty template restores the default message of the rule. Templates apply
+// to every error, including those already recorded
+func SetMessages(templates map[string]string) {
+	messagesMu.Lock()
+	defer messagesMu.Unlock()
+
+	for rule, template := range templates {
+		if template == "" {
+			delete(messages, rule)
+			continue
+		}
+		messages[rule] = template
+	}
+}
+
+// RegisterMessages adds the default message templates of the given rules in a
+// given locale, e.g. the rules of packages extending Assertion, which should
+// prefix their rule names with the package name, e.g. "httpassert.json". They
+// do not replace the built-in templates of the same rules, which SetMessages
+// does, nor the default messages of the rules registered with RegisterRule
+func RegisterMessages(locale string, templates map[string]string) {
+	messagesMu.Lock()
+	defer messagesMu.Unlock()
+
+	locale = localeCandidates(locale)[0]
+	if registeredCatalog[locale] == nil {
+		registeredCatalog[locale] = make(map[string]string, len(templates))
+	}
+
+	for rule, template := range templates {
+		registeredCatalog[locale][rule] = template
+	}
+}
+
+// messageTemplate returns the current message template of a given rule. The
+// default message of a rule registered with RegisterRule comes before the
+// templates added with RegisterMessages
+func messageTemplate(rule string) string {
+	messagesMu.RLock()
+	template, ok := messages[rule]
+	if !ok {
+		template, ok = defaultMessages[rule]
+	}
+	messagesMu.RUnlock()
+	if ok {
+		return template
+	}
+
+	if r, ok := lookupRule(rule); ok {
+		return r.defaultMsg
+	}
+
+	messagesMu.RLock()
+	template, ok = registeredCatalog["en"][rule]
+	messagesMu.RUnlock()
+	if ok {
+		return template
+	}
+
+	return errMsgUnknownTemplate
+}
+
+// builtinTemplate returns the message template of a given rule in a given locale
+// from the built-in catalogues, or else from the registered ones
+func builtinTemplate(locale, rule string) (string, bool) {
+	if template, ok := builtinCatalog.Translate(locale, rule); ok {
+		return template, true
+	}
+
+	messagesMu.RLock()
+	defer messagesMu.RUnlock()
+
+	return registeredCatalog.Translate(locale, rule)
+}
+
+// hasPlaceholders returns true if a given string contains template placeholders
+func hasPlaceholders(s string) bool {
+	return rexPlaceholder.MatchString(s)
+}
+
+// referencesField returns true if a given template has a field placeholder
+func referencesField(template string) bool {
+	for _, m := range rexPlaceholder.FindAllStringSubmatch(template, -1) {
+		if strings.EqualFold(m[1]+m[2], "field") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// renderTemplate replaces the placeholders of a given template by the values
+// of data with the same name, case insensitively, in their default format.
+// Unknown placeholders are left untouched
+func renderTemplate(template string, data map[string]interface{}) string {
+	return rexPlaceholder.ReplaceAllStringFunc(template, func(placeholder string) string {
+		m := rexPlaceholder.FindStringSubmatch(placeholder)
+		name := m[1] + m[2]
+		for k, v := range data {
+			if strings.EqualFold(k, name) {
+				return fmt.Sprint(v)
+			}
+		}
+
+		return placeholder
+	})
+}
@@ -0,0 +1,106 @@
+package assertion
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSetMessages(t *testing.T) {
+	defer SetMessages(map[string]string{"gt": "", "email": ""})
+
+	a := New()
+	a.GreaterThan(1, 5)
+	a.ThatString("contact", "foo").Email()
+
+	SetMessages(map[string]string{
+		"gt":    "{{.Value}} must be above {{.Other}}",
+		"email": "{field} must be an email address, got {value}",
+	})
+
+	assert.EqualError(t, a.ErrorAt(0), "1 must be above 5")
+	assert.EqualError(t, a.ErrorAt(1), "contact must be an email address, got foo")
+
+	SetMessages(map[string]string{"gt": "", "email": ""})
+
+	assert.EqualError(t, a.ErrorAt(0), "1 is not greater than 5")
+	assert.EqualError(t, a.ErrorAt(1), "contact: foo is not a valid email")
+}
+
+func TestAssertion_TemplateMessage(t *testing.T) {
+	a := New()
+
+	assert.False(t, a.GreaterThan(1, 5, "{{.Value}} must be above {{.Other}}"))
+	assert.False(t, a.Between(7, 1, 5, "{value} out of [{min}, {max}]"))
+	assert.False(t, a.That("age", 1).GreaterThan(5, "{field} must be above {other}").Valid())
+	assert.False(t, a.GreaterThan(1, 5, "{unknown} value"))
+
+	assert.EqualError(t, a.ErrorAt(0), "1 must be above 5")
+	assert.EqualError(t, a.ErrorAt(1), "7 out of [1, 5]")
+	assert.EqualError(t, a.ErrorAt(2), "age must be above 5")
+	assert.EqualError(t, a.ErrorAt(3), "{unknown} value")
+}
+
+func TestError(t *testing.T) {
+	a := New()
+	a.That("age", 200).Between(0, 150)
+
+	var e *Error
+	assert.True(t, errors.As(a.ErrorAt(0), &e))
+	assert.Equal(t, "between", e.Rule)
+	assert.Equal(t, "age", e.Field)
+	assert.Equal(t, 200, e.Value)
+	assert.Equal(t, map[string]interface{}{"Min": 0, "Max": 150}, e.Params)
+	assert.Equal(t, "200 is not between 0 and 150", e.Message())
+	assert.Equal(t, "age: 200 is not between 0 and 150", e.Error())
+}
+
+func TestNewError_InvalidFormat(t *testing.T) {
+	a := New()
+
+	assert.PanicsWithError(t, "message format 1 is not a string", func() {
+		a.Email("x", 1, 2)
+	})
+	assert.NotPanics(t, func() { a.Email("x", 1) })
+	assert.EqualError(t, a.ErrorAt(0), "1")
+}
+
+func TestRegisterMessages(t *testing.T) {
+	RegisterMessages("en", map[string]string{"email": "overridden", "testsku": "{{.Value}} is not a valid sku"})
+	RegisterMessages("es", map[string]string{"email": "sobrescrito", "testsku": "{{.Value}} no es un sku válido"})
+
+	a := New()
+	a.Email("foo")
+	a.AddError(NewError("testsku", "X1", nil))
+
+	assert.EqualError(t, a.ErrorAt(0), "foo is not a valid email")
+	assert.EqualError(t, a.ErrorAt(1), "X1 is not a valid sku")
+	assert.Equal(t, "foo no es un email válido", a.ErrorAt(0).(*Error).Localize("es"))
+	assert.Equal(t, "X1 no es un sku válido", a.ErrorAt(1).(*Error).Localize("es"))
+}
+
+func TestRegisterMessages_RegisteredRule(t *testing.T) {
+	RegisterMessages("en", map[string]string{"testproducttype": "{{.Value}} is not of type {{.Type}}"})
+	RegisterRule("testproducttype", func(value interface{}, params ...interface{}) bool { return false }, "%v has a wrong product type")
+
+	a := New()
+	a.Rule("testproducttype", "sku-1", nil)
+
+	assert.EqualError(t, a.ErrorAt(0), "sku-1 has a wrong product type")
+}
+
+func TestRegisterMessages_Concurrent(t *testing.T) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			RegisterMessages("en", map[string]string{"testconcurrent": "value is invalid"})
+		}
+	}()
+
+	for i := 0; i < 100; i++ {
+		_ = NewError("testconcurrent", nil, nil).Error()
+		_ = NewError("email", "foo", nil).Localize("fr")
+	}
+	<-done
+}
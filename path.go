@@ -0,0 +1,468 @@
+package assertion
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// regexpPathName matches the child names that need no brackets in a path
+var regexpPathName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// pathNameEscaper escapes the quoted child names of a path
+var pathNameEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+// pointerUnescaper unescapes the reference tokens of a JSON Pointer, RFC 6901
+var pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// pointerEscaper escapes the reference tokens of a JSON Pointer, RFC 6901
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// EscapePointer returns a given name escaped as a reference token of a JSON
+// Pointer, RFC 6901, e.g. "a~1b" for "a/b"
+func EscapePointer(name string) string {
+	return pointerEscaper.Replace(name)
+}
+
+// stepKind determines how a path step selects the children of a value
+type stepKind int
+
+const (
+	stepName stepKind = iota
+	stepIndex
+	stepToken
+	stepWildcard
+)
+
+// pathStep is a step of a path: a child name, an array index, a JSON Pointer
+// reference token or a wildcard
+type pathStep struct {
+	kind  stepKind
+	name  string
+	index int
+}
+
+// pathNode is a value matched by a path and the concrete path of the value
+type pathNode struct {
+	path  string
+	value interface{}
+}
+
+// Nodes is a fluent assertion chain over the values of a decoded JSON document
+// matched by a path, created with Assertion.At. Every assertion is applied to
+// each value, whose chain stops on its first failure
+type Nodes struct {
+	values []*Value
+	failed bool
+}
+
+// At returns a fluent assertion chain over the values of a decoded JSON
+// document, made of map[string]interface{} and []interface{}, matched by a
+// given path, e.g. a.At(doc, "$.items[*].price").GreaterThan(0). The path is
+// either a JSONPath of child names, indexes and wildcards, e.g.
+// $.items[0]['unit price'] or items[*].price, or a JSON Pointer, e.g.
+// /items/0/price. The errors are prefixed by the concrete path of each value,
+// e.g. "items[1].price". A value missing at the path fails as missing, while a
+// wildcard over an empty array or object matches nothing
+func (a *Assertion) At(doc interface{}, path string) *Nodes {
+	n := &Nodes{}
+	if a.done() {
+		n.failed = true
+		return n
+	}
+
+	steps, pointer, err := parsePath(path)
+	if err != nil {
+		n.failed = true
+		a.addError(err)
+		return n
+	}
+
+	nodes, missing := evalPath(doc, steps, pointer)
+	for _, node := range nodes {
+		n.values = append(n.values, a.That(node.path, node.value))
+	}
+
+	for _, path := range missing {
+		n.failed = true
+		a.addFieldErrors(path, []error{NewError("missing", nil, nil)})
+	}
+
+	return n
+}
+
+// Len returns the number of values matched by the path
+func (n *Nodes) Len() int {
+	return len(n.values)
+}
+
+// Valid returns true if the path is valid, no value is missing and no
+// assertion of any value has failed
+func (n *Nodes) Valid() bool {
+	if n.failed {
+		return false
+	}
+
+	for _, v := range n.values {
+		if !v.Valid() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Each calls a given function with the fluent assertion chain of every value
+func (n *Nodes) Each(fn func(v *Value)) *Nodes {
+	for _, v := range n.values {
+		fn(v)
+	}
+
+	return n
+}
+
+// Nil asserts that every value is null
+func (n *Nodes) Nil(msgArgs ...interface{}) *Nodes {
+	return n.Each(func(v *Value) { v.Nil(msgArgs...) })
+}
+
+// Equal asserts that every value is equal to other value
+func (n *Nodes) Equal(other interface{}, msgArgs ...interface{}) *Nodes {
+	return n.Each(func(v *Value) { v.Equal(coerceNumber(v.value, other), msgArgs...) })
+}
+
+// GreaterThan asserts that every value is greater than other value
+func (n *Nodes) GreaterThan(other interface{}, msgArgs ...interface{}) *Nodes {
+	return n.Each(func(v *Value) { v.GreaterThan(coerceNumber(v.value, other), msgArgs...) })
+}
+
+// GreaterThanOrEqual asserts that every value is greater than or equal to other value
+func (n *Nodes) GreaterThanOrEqual(other interface{}, msgArgs ...interface{}) *Nodes {
+	return n.Each(func(v *Value) { v.GreaterThanOrEqual(coerceNumber(v.value, other), msgArgs...) })
+}
+
+// LowerThan asserts that every value is lower than other value
+func (n *Nodes) LowerThan(other interface{}, msgArgs ...interface{}) *Nodes {
+	return n.Each(func(v *Value) { v.LowerThan(coerceNumber(v.value, other), msgArgs...) })
+}
+
+// LowerThanOrEqual asserts that every value is lower than or equal to other value
+func (n *Nodes) LowerThanOrEqual(other interface{}, msgArgs ...interface{}) *Nodes {
+	return n.Each(func(v *Value) { v.LowerThanOrEqual(coerceNumber(v.value, other), msgArgs...) })
+}
+
+// Between asserts that every value is between a lower and upper limit (including both)
+func (n *Nodes) Between(lower, upper interface{}, msgArgs ...interface{}) *Nodes {
+	return n.Each(func(v *Value) {
+		v.Between(coerceNumber(v.value, lower), coerceNumber(v.value, upper), msgArgs...)
+	})
+}
+
+// BetweenExclude asserts that every value is between a lower and upper limit
+// (excluding both)
+func (n *Nodes) BetweenExclude(lower, upper interface{}, msgArgs ...interface{}) *Nodes {
+	return n.Each(func(v *Value) {
+		v.BetweenExclude(coerceNumber(v.value, lower), coerceNumber(v.value, upper), msgArgs...)
+	})
+}
+
+// HasKey asserts that every value is an object with the given key
+func (n *Nodes) HasKey(key interface{}, msgArgs ...interface{}) *Nodes {
+	return n.Each(func(v *Value) { v.HasKey(key, msgArgs...) })
+}
+
+// HasKeys asserts that every value is an object with all the given keys
+func (n *Nodes) HasKeys(keys ...interface{}) *Nodes {
+	return n.Each(func(v *Value) { v.HasKeys(keys...) })
+}
+
+// HasOnlyKeys asserts that every value is an object without keys other than the given ones
+func (n *Nodes) HasOnlyKeys(keys interface{}, msgArgs ...interface{}) *Nodes {
+	return n.Each(func(v *Value) { v.HasOnlyKeys(keys, msgArgs...) })
+}
+
+// Rule asserts that every value satisfies the rule registered under a given name
+func (n *Nodes) Rule(name string, params ...interface{}) *Nodes {
+	return n.Each(func(v *Value) { v.Rule(name, params...) })
+}
+
+// Apply asserts that every value satisfies a given compiled rule string, e.g.
+// MustCompile("required,email")
+func (n *Nodes) Apply(rule *CompiledRule) *Nodes {
+	return n.Each(func(v *Value) {
+		v.Check(func(a *Assertion) bool { return a.Apply(rule, v.value) })
+	})
+}
+
+// coerceNumber returns other operand as a float64 if it is a Go number and a
+// given value is a JSON number, so that they can be compared
+func coerceNumber(value, other interface{}) interface{} {
+	if _, ok := value.(float64); !ok {
+		return other
+	}
+
+	switch reflect.ValueOf(other).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32:
+		f, _ := toFloat(other)
+		return f
+	}
+
+	return other
+}
+
+// parsePath parses a JSON Pointer, if a given path is empty or starts with a
+// slash, otherwise a JSONPath
+func parsePath(path string) ([]pathStep, bool, error) {
+	if path == "" || strings.HasPrefix(path, "/") {
+		return parsePointer(path), true, nil
+	}
+
+	steps, err := parseJSONPath(path)
+	return steps, false, err
+}
+
+// parsePointer parses the reference tokens of a JSON Pointer
+func parsePointer(path string) []pathStep {
+	if path == "" {
+		return nil
+	}
+
+	tokens := strings.Split(path[1:], "/")
+	steps := make([]pathStep, 0, len(tokens))
+	for _, token := range tokens {
+		steps = append(steps, pathStep{kind: stepToken, name: pointerUnescaper.Replace(token)})
+	}
+
+	return steps
+}
+
+// parseJSONPath parses a JSONPath made of .name, .*, [index], [*], ['name'] and
+// ["name"] steps, starting with $ or a name. Quoted names escape their quote
+// and backslashes with a backslash, e.g. ['it\'s']
+func parseJSONPath(path string) ([]pathStep, error) {
+	steps := make([]pathStep, 0)
+	i := 0
+	if strings.HasPrefix(path, "$") {
+		i++
+	}
+
+	for i < len(path) {
+		switch {
+		case path[i] == '[':
+			step, end, msg := parseBracket(path, i)
+			if msg != "" {
+				return nil, pathSyntaxError(path, i, msg)
+			}
+			steps = append(steps, step)
+			i = end
+		case path[i] == '.' || i == 0:
+			if path[i] == '.' {
+				i++
+			}
+
+			if strings.HasPrefix(path[i:], "*") {
+				steps = append(steps, pathStep{kind: stepWildcard})
+				i++
+				continue
+			}
+
+			end := i
+			for end < len(path) && path[end] != '.' && path[end] != '[' {
+				end++
+			}
+			if end == i {
+				return nil, pathSyntaxError(path, i, "expected a name")
+			}
+			steps = append(steps, pathStep{kind: stepName, name: path[i:end]})
+			i = end
+		default:
+			return nil, pathSyntaxError(path, i, fmt.Sprintf("unexpected %q", path[i]))
+		}
+	}
+
+	return steps, nil
+}
+
+// parseBracket parses the bracket step of a path starting at a given offset: *,
+// an index or a quoted name. It returns the step and the offset after the
+// closing bracket, or the message of the syntax error
+func parseBracket(path string, start int) (pathStep, int, string) {
+	i := start + 1
+	if i < len(path) && (path[i] == '\'' || path[i] == '"') {
+		name, end, ok := unquotePathName(path, i)
+		if !ok {
+			return pathStep{}, 0, "missing closing " + string(path[i])
+		}
+		if end >= len(path) || path[end] != ']' {
+			return pathStep{}, 0, "missing closing ]"
+		}
+		return pathStep{kind: stepName, name: name}, end + 1, ""
+	}
+
+	end := strings.IndexByte(path[i:], ']')
+	if end < 0 {
+		return pathStep{}, 0, "missing closing ]"
+	}
+
+	text := path[i : i+end]
+	if text == "*" {
+		return pathStep{kind: stepWildcard}, i + end + 1, ""
+	}
+
+	index, err := strconv.Atoi(text)
+	if err != nil || index < 0 {
+		return pathStep{}, 0, "invalid [" + text + "]"
+	}
+
+	return pathStep{kind: stepIndex, index: index}, i + end + 1, ""
+}
+
+// unquotePathName returns the name quoted at a given offset of a path, with
+// its escapes removed, and the offset after the closing quote
+func unquotePathName(path string, start int) (string, int, bool) {
+	quote := path[start]
+	var b strings.Builder
+	for i := start + 1; i < len(path); i++ {
+		switch path[i] {
+		case quote:
+			return b.String(), i + 1, true
+		case '\\':
+			i++
+			if i == len(path) {
+				return "", 0, false
+			}
+		}
+		b.WriteByte(path[i])
+	}
+
+	return "", 0, false
+}
+
+// pathSyntaxError returns the syntax error of a path at a given offset
+func pathSyntaxError(path string, offset int, msg string) error {
+	return &SyntaxError{Source: path, Offset: offset, Msg: msg, path: true}
+}
+
+// evalPath returns the values of a document matched by the steps of a path and
+// the concrete paths of the values missing on the way
+func evalPath(doc interface{}, steps []pathStep, pointer bool) ([]pathNode, []string) {
+	nodes := []pathNode{{value: doc}}
+	missing := make([]string, 0)
+	for _, step := range steps {
+		next := make([]pathNode, 0, len(nodes))
+		for _, node := range nodes {
+			children, ok := step.children(node, pointer)
+			if !ok {
+				missing = append(missing, step.childPath(node.path, pointer))
+			}
+			next = append(next, children...)
+		}
+		nodes = next
+	}
+
+	for i := range nodes {
+		if n, ok := nodes[i].value.(json.Number); ok {
+			if f, err := n.Float64(); err == nil {
+				nodes[i].value = f
+			}
+		}
+	}
+
+	return nodes, missing
+}
+
+// children returns the children of a node selected by the step, or false if
+// the selected child is missing
+func (s pathStep) children(node pathNode, pointer bool) ([]pathNode, bool) {
+	switch v := node.value.(type) {
+	case map[string]interface{}:
+		if s.kind == stepWildcard {
+			keys := make([]string, 0, len(v))
+			for key := range v {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+
+			children := make([]pathNode, 0, len(v))
+			for _, key := range keys {
+				children = append(children, pathNode{path: childPath(node.path, key, pointer), value: v[key]})
+			}
+			return children, true
+		}
+
+		if s.kind == stepName || s.kind == stepToken {
+			if value, ok := v[s.name]; ok {
+				return []pathNode{{path: childPath(node.path, s.name, pointer), value: value}}, true
+			}
+		}
+	case []interface{}:
+		if s.kind == stepWildcard {
+			children := make([]pathNode, 0, len(v))
+			for i, value := range v {
+				children = append(children, pathNode{path: indexPath(node.path, i, pointer), value: value})
+			}
+			return children, true
+		}
+
+		index, ok := s.index, s.kind == stepIndex
+		if s.kind == stepToken {
+			index, ok = pointerIndex(s.name)
+		}
+		if ok && index < len(v) {
+			return []pathNode{{path: indexPath(node.path, index, pointer), value: v[index]}}, true
+		}
+	}
+
+	return nil, false
+}
+
+// childPath returns the concrete path of the child selected by the step
+func (s pathStep) childPath(parent string, pointer bool) string {
+	switch s.kind {
+	case stepIndex:
+		return indexPath(parent, s.index, pointer)
+	case stepWildcard:
+		return joinPath(parent, "[*]")
+	}
+
+	return childPath(parent, s.name, pointer)
+}
+
+// childPath returns the concrete path of a named child of a value
+func childPath(parent, name string, pointer bool) string {
+	if pointer {
+		return parent + "/" + EscapePointer(name)
+	}
+
+	if regexpPathName.MatchString(name) {
+		return joinPath(parent, name)
+	}
+
+	return joinPath(parent, "['"+pathNameEscaper.Replace(name)+"']")
+}
+
+// indexPath returns the concrete path of an item of an array
+func indexPath(parent string, index int, pointer bool) string {
+	if pointer {
+		return parent + "/" + strconv.Itoa(index)
+	}
+
+	return joinPath(parent, "["+strconv.Itoa(index)+"]")
+}
+
+// pointerIndex returns the array index of a JSON Pointer reference token, which
+// has no sign nor leading zeros
+func pointerIndex(token string) (int, bool) {
+	if token == "" || (len(token) > 1 && token[0] == '0') || strings.TrimLeft(token, "0123456789") != "" {
+		return 0, false
+	}
+
+	index, err := strconv.Atoi(token)
+	return index, err == nil
+}
@@ -0,0 +1,148 @@
+package assertion
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+const webhookPayload = `{
+  "id": "evt_1",
+  "type": "order.paid",
+  "data": {
+    "customer": {"email": "foo@example.com"},
+    "items": [
+      {"sku": "abc-1", "price": 9.99, "quantity": 2},
+      {"sku": "abc-2", "price": 0, "quantity": 1},
+      {"sku": "abc-3", "quantity": 3},
+      {"sku": "abc/4", "price": -5, "quantity": 100}
+    ],
+    "discounts": [],
+    "metadata": {"unit price": "10", "a/b~c": "x"}
+  }
+}`
+
+func decodePayload(t *testing.T, useNumber bool) interface{} {
+	dec := json.NewDecoder(strings.NewReader(webhookPayload))
+	if useNumber {
+		dec.UseNumber()
+	}
+
+	var doc interface{}
+	assert.NoError(t, dec.Decode(&doc))
+	return doc
+}
+
+func TestAssertion_At(t *testing.T) {
+	for _, useNumber := range []bool{false, true} {
+		a := New()
+		doc := decodePayload(t, useNumber)
+
+		assert.True(t, a.At(doc, "$.type").Equal("order.paid").Valid())
+		assert.True(t, a.At(doc, "data.customer.email").Apply(MustCompile("email")).Valid())
+		assert.True(t, a.At(doc, "$.data.items[0].quantity").Between(1, 10).Valid())
+		assert.True(t, a.At(doc, "$.data.metadata['unit price']").Apply(MustCompile("required,float")).Valid())
+		assert.True(t, a.At(doc, "/data/metadata/a~1b~0c").Equal("x").Valid())
+		assert.True(t, a.At(doc, "$.data.items[*]").HasKeys("sku", "quantity").Valid())
+		assert.Equal(t, 4, a.At(doc, "$.data.items[*].sku").Len())
+		assert.Equal(t, 2, a.At(doc, "$.data.metadata.*").Len())
+		assert.False(t, a.HasErrors())
+	}
+}
+
+func TestAssertion_At_Wildcard(t *testing.T) {
+	for _, useNumber := range []bool{false, true} {
+		a := New()
+		doc := decodePayload(t, useNumber)
+
+		assert.False(t, a.At(doc, "$.data.items[*].price").GreaterThan(0).LowerThan(-10).Valid())
+		assert.False(t, a.At(doc, "/data/items/3/quantity").LowerThanOrEqual(99).Valid())
+		assert.False(t, a.At(doc, `$.data.items[*]["sku"]`).Apply(MustCompile("slug")).Valid())
+
+		assert.EqualError(t, a.Err(), "data.items[2].price: value is missing; "+
+			"data.items[1].price: 0 is not greater than 0; "+
+			"data.items[3].price: -5 is not greater than 0; "+
+			"data.items[0].price: 9.99 is not lower than -10; "+
+			"/data/items/3/quantity: 100 is not lower than or equal 99; "+
+			"data.items[3].sku: abc/4 is not a valid slug")
+	}
+}
+
+func TestAssertion_At_Missing(t *testing.T) {
+	a := New(WithLocale("es"))
+	doc := decodePayload(t, false)
+
+	assert.False(t, a.At(doc, "$.data.refunds[*].amount").GreaterThan(0).Valid())
+	assert.False(t, a.At(doc, "$.data.items[9]").Valid())
+	assert.False(t, a.At(doc, "$.data.metadata['unit price'].currency").Valid())
+	assert.False(t, a.At(doc, "/data/items/01").Valid())
+	assert.False(t, a.At(doc, "$.data.customer.*.id").Valid())
+	assert.True(t, a.At(doc, "$.data.discounts[*].amount").GreaterThan(0).Valid())
+
+	assert.EqualError(t, a.Err(), `data.refunds: falta el valor; `+
+		`data.items[9]: falta el valor; `+
+		`data.metadata['unit price'].currency: falta el valor; `+
+		`/data/items/01: falta el valor; `+
+		`data.customer.email.id: falta el valor`)
+}
+
+func TestAssertion_At_Root(t *testing.T) {
+	a := New()
+
+	assert.True(t, a.At([]interface{}{1.0, 2.0}, "$[*]").Between(1, 2).Valid())
+	assert.False(t, a.At(map[string]interface{}{}, "").HasKey("id").Valid())
+	assert.False(t, a.At(nil, "$").Equal(1).Valid())
+
+	assert.Equal(t, 2, a.CountErrors())
+	assert.EqualError(t, a.ErrorAt(0), "map[] has not the key id")
+	assert.EqualError(t, a.ErrorAt(1), "<nil> and 1 are not of the same type")
+}
+
+func TestAssertion_At_SyntaxError(t *testing.T) {
+	tests := []struct {
+		path string
+		err  string
+	}{
+		{"$.items[0", `path "$.items[0" at offset 7: missing closing ]`},
+		{"$.items[-1]", `path "$.items[-1]" at offset 7: invalid [-1]`},
+		{"$.items..price", `path "$.items..price" at offset 8: expected a name`},
+		{"$items", `path "$items" at offset 1: unexpected 'i'`},
+		{"$['a]", `path "$['a]" at offset 1: missing closing '`},
+		{`$["a\"]`, `path "$[\"a\\\"]" at offset 1: missing closing "`},
+		{"$['a'b]", `path "$['a'b]" at offset 1: missing closing ]`},
+	}
+
+	for _, tt := range tests {
+		a := New()
+		assert.False(t, a.At(nil, tt.path).Equal(1).Valid(), tt.path)
+		assert.Equal(t, 1, a.CountErrors(), tt.path)
+		assert.EqualError(t, a.ErrorAt(0), tt.err, tt.path)
+
+		var syntaxErr *SyntaxError
+		assert.True(t, errors.As(a.ErrorAt(0), &syntaxErr), tt.path)
+	}
+}
+
+func TestAssertion_At_QuotedNames(t *testing.T) {
+	doc := map[string]interface{}{"a]b": 1.0, `a"b`: 2.0, `it's \`: 3.0}
+
+	a := New()
+	assert.True(t, a.At(doc, "$['a]b']").Equal(1.0).Valid())
+	assert.True(t, a.At(doc, `$["a\"b"]`).Equal(2.0).Valid())
+	assert.True(t, a.At(doc, `$['it\'s \\']`).Equal(3.0).Valid())
+	assert.False(t, a.At(doc, "$['a]b']").Equal(0.0).Valid())
+	assert.False(t, a.At(doc, `$["it's \\"]`).Equal(0.0).Valid())
+	assert.False(t, a.At(doc, `$["it's \\"].x`).Valid())
+
+	assert.Equal(t, 3, a.CountErrors())
+	assert.EqualError(t, a.ErrorAt(0), "['a]b']: 1 is not equal 0")
+	assert.EqualError(t, a.ErrorAt(1), `['it\'s \\']: 3 is not equal 0`)
+	assert.EqualError(t, a.ErrorAt(2), `['it\'s \\'].x: value is missing`)
+}
+
+func TestEscapePointer(t *testing.T) {
+	assert.Equal(t, "a~1b~0c", EscapePointer("a/b~c"))
+	assert.Equal(t, "name", EscapePointer("name"))
+}
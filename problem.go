@@ -0,0 +1,112 @@
+package assertion
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ProblemContentType is the media type of a Problem encoded as JSON
+const ProblemContentType = "application/problem+json"
+
+// Problem is a problem details object as defined by RFC 9457 (formerly RFC
+// 7807), holding the errors of an Assertion as the errors extension member
+type Problem struct {
+	Type     string         `json:"type"`
+	Title    string         `json:"title"`
+	Status   int            `json:"status"`
+	Detail   string         `json:"detail,omitempty"`
+	Instance string         `json:"instance,omitempty"`
+	Errors   []ProblemError `json:"errors"`
+}
+
+// ProblemError is the detail of a single error of an Assertion. Rule and Params
+// are only known for errors of failed assertions, see Error
+type ProblemError struct {
+	Field   string                 `json:"field,omitempty"`
+	Rule    string                 `json:"rule,omitempty"`
+	Message string                 `json:"message"`
+	Params  map[string]interface{} `json:"params,omitempty"`
+}
+
+// ProblemDetails returns the problem details of the current errors, with the
+// 422 Unprocessable Content status. Type, Title, Detail and Instance can be
+// changed before encoding it with json.Marshal
+func (a *Assertion) ProblemDetails() Problem {
+	errs := a.problemErrors()
+
+	return Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(http.StatusUnprocessableEntity),
+		Status: http.StatusUnprocessableEntity,
+		Detail: errMsgProblemDetail,
+		Errors: errs,
+	}
+}
+
+// MarshalJSON returns the current errors as a JSON object with an errors array
+// of {field, rule, message, params} elements, e.g. json.Marshal(a)
+func (a Assertion) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Errors []ProblemError `json:"errors"`
+	}{a.problemErrors()})
+}
+
+// problemErrors returns the details of the current errors
+func (a *Assertion) problemErrors() []ProblemError {
+	a.lock()
+	errs := append([]error(nil), a.errors...)
+	a.unlock()
+
+	details := make([]ProblemError, len(errs))
+	for i, err := range errs {
+		details[i] = newProblemError(err)
+	}
+
+	return details
+}
+
+// newProblemError returns the detail of a given error
+func newProblemError(err error) ProblemError {
+	switch e := err.(type) {
+	case *Error:
+		return ProblemError{Field: e.Field, Rule: e.Rule, Message: e.Message(), Params: problemParams(e)}
+	case *fieldError:
+		return ProblemError{Field: e.field, Message: e.err.Error()}
+	}
+
+	return ProblemError{Message: err.Error()}
+}
+
+// problemParams returns the parameters of a given error as values that encode
+// to meaningful JSON: nested errors as their messages and other values that
+// are not JSON or text marshalers as their string form, e.g. regexp patterns
+func problemParams(e *Error) map[string]interface{} {
+	if len(e.Params) == 0 {
+		return nil
+	}
+
+	params := make(map[string]interface{}, len(e.Params))
+	for k, v := range e.Params {
+		switch p := v.(type) {
+		case errorList:
+			msgs := make([]string, len(p))
+			for i, err := range p {
+				msgs[i] = err.Error()
+				if inner, ok := err.(*Error); ok {
+					msgs[i] = inner.Localize(e.locale)
+				}
+			}
+			v = msgs
+		case json.Marshaler, encoding.TextMarshaler:
+		case error:
+			v = p.Error()
+		case fmt.Stringer:
+			v = p.String()
+		}
+		params[k] = v
+	}
+
+	return params
+}
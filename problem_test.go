@@ -0,0 +1,83 @@
+package assertion
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAssertion_MarshalJSON(t *testing.T) {
+	a := New()
+	a.That("age", 200).Between(0, 150)
+	a.ThatString("code", "abc").Matches(`^\d+$`)
+	a.AddError(errors.New("custom failure"))
+
+	data, err := json.Marshal(&a)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"errors": [
+		{"field": "age", "rule": "between", "message": "200 is not between 0 and 150", "params": {"Min": 0, "Max": 150}},
+		{"field": "code", "rule": "matches", "message": "abc does not match ^\\d+$", "params": {"Pattern": "^\\d+$"}},
+		{"message": "custom failure"}
+	]}`, string(data))
+}
+
+func TestAssertion_MarshalJSON_Value(t *testing.T) {
+	a := New()
+	a.Email("foo")
+
+	data, err := json.Marshal(a)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"errors": [{"rule": "email", "message": "foo is not a valid email"}]}`, string(data))
+
+	data, err = json.Marshal(struct{ Result Assertion }{a})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"Result": {"errors": [{"rule": "email", "message": "foo is not a valid email"}]}}`, string(data))
+}
+
+func TestAssertion_MarshalJSON_NoErrors(t *testing.T) {
+	a := New()
+
+	data, err := json.Marshal(&a)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"errors": []}`, string(data))
+}
+
+func TestAssertion_ProblemDetails(t *testing.T) {
+	a := New(WithLocale("es"))
+	a.That("age", 200).Between(0, 150)
+	a.Any([]CheckFunc{
+		func(a *Assertion) bool { return a.Ipv4("foo") },
+		func(a *Assertion) bool { return a.Email("foo") },
+	})
+
+	p := a.ProblemDetails()
+	p.Instance = "/users"
+
+	data, err := json.Marshal(p)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"type": "about:blank",
+		"title": "Unprocessable Entity",
+		"status": 422,
+		"detail": "one or more assertions failed",
+		"instance": "/users",
+		"errors": [
+			{"field": "age", "rule": "between", "message": "200 no está entre 0 y 150", "params": {"Min": 0, "Max": 150}},
+			{"rule": "any", "message": "ninguna alternativa se cumple: foo no es una ipv4 válida; foo no es un email válido", "params": {"Errors": ["foo no es una ipv4 válida", "foo no es un email válido"]}}
+		]
+	}`, string(data))
+}
+
+func TestAssertion_MarshalJSON_Concurrent(t *testing.T) {
+	a := New()
+	a.Go(func(a *Assertion) { a.Email("plainaddress") })
+
+	data, err := json.Marshal(&a)
+	assert.NoError(t, err)
+	assert.True(t, json.Valid(data))
+
+	a.Wait()
+	data, _ = json.Marshal(&a)
+	assert.Contains(t, string(data), "plainaddress is not a valid email")
+}
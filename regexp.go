@@ -0,0 +1,90 @@
+package assertion
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+)
+
+// regexpCacheSize is the maximum number of compiled patterns kept in cache
+const regexpCacheSize = 256
+
+// ErrInvalidPattern is the error category of failures caused by a regular
+// expression that cannot be compiled. It can be checked with errors.Is
+var ErrInvalidPattern = errors.New("invalid pattern")
+
+// regexpCache is a least recently used cache of compiled regular expressions
+type regexpCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+// regexpCacheEntry is an element of the regexpCache order list
+type regexpCacheEntry struct {
+	pattern string
+	rex     *regexp.Regexp
+}
+
+var patterns = newRegexpCache(regexpCacheSize)
+
+// newRegexpCache creates and returns a new regexpCache holding up to size patterns
+func newRegexpCache(size int) *regexpCache {
+	return &regexpCache{size: size, order: list.New(), entries: make(map[string]*list.Element)}
+}
+
+// get returns the compiled regular expression of a given pattern, compiling and
+// storing it if it is not in cache yet. Invalid patterns are not stored
+func (c *regexpCache) get(pattern string) (*regexp.Regexp, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[pattern]; ok {
+		c.order.MoveToFront(e)
+		return e.Value.(*regexpCacheEntry).rex, nil
+	}
+
+	rex, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	c.entries[pattern] = c.order.PushFront(&regexpCacheEntry{pattern: pattern, rex: rex})
+	if c.order.Len() > c.size {
+		last := c.order.Back()
+		c.order.Remove(last)
+		delete(c.entries, last.Value.(*regexpCacheEntry).pattern)
+	}
+
+	return rex, nil
+}
+
+// len returns the number of patterns currently in cache
+func (c *regexpCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// compilePattern returns the regular expression given as a string pattern or as
+// an already compiled *regexp.Regexp. Errors wrap ErrInvalidPattern
+func compilePattern(pattern interface{}) (*regexp.Regexp, error) {
+	switch p := pattern.(type) {
+	case *regexp.Regexp:
+		if p != nil {
+			return p, nil
+		}
+	case string:
+		rex, err := patterns.get(p)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPattern, err)
+		}
+		return rex, nil
+	}
+
+	return nil, fmt.Errorf("%w: %v is not a string or *regexp.Regexp", ErrInvalidPattern, pattern)
+}
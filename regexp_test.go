@@ -0,0 +1,51 @@
+package assertion
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRegexpCache_Get(t *testing.T) {
+	c := newRegexpCache(2)
+
+	r1, err := c.get(`^a$`)
+	assert.NoError(t, err)
+	r2, err := c.get(`^a$`)
+	assert.NoError(t, err)
+	assert.Same(t, r1, r2)
+	assert.Equal(t, 1, c.len())
+
+	_, err = c.get(`(a`)
+	assert.Error(t, err)
+	assert.Equal(t, 1, c.len())
+}
+
+func TestRegexpCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := newRegexpCache(2)
+
+	a, _ := c.get(`a`)
+	c.get(`b`)
+	c.get(`a`)
+	c.get(`c`)
+
+	assert.Equal(t, 2, c.len())
+	assert.Contains(t, c.entries, `a`)
+	assert.Contains(t, c.entries, `c`)
+	assert.NotContains(t, c.entries, `b`)
+
+	again, _ := c.get(`a`)
+	assert.Same(t, a, again)
+}
+
+func TestCompilePattern(t *testing.T) {
+	_, err := compilePattern(`[`)
+	assert.True(t, errors.Is(err, ErrInvalidPattern))
+
+	_, err = compilePattern(1)
+	assert.True(t, errors.Is(err, ErrInvalidPattern))
+
+	rex, err := compilePattern(`^\d+$`)
+	assert.NoError(t, err)
+	assert.True(t, rex.MatchString("123"))
+}
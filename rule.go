@@ -0,0 +1,95 @@
+package assertion
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrUnknownRule is the error category of failures caused by applying a rule
+// that has not been registered. It can be checked with errors.Is
+var ErrUnknownRule = errors.New("unknown rule")
+
+// RuleFunc is a user defined assertion. It returns true if a given value
+// satisfies the rule for the given parameters
+type RuleFunc func(value interface{}, params ...interface{}) bool
+
+// registeredRule is a user defined assertion registered with RegisterRule
+type registeredRule struct {
+	fn         RuleFunc
+	defaultMsg string
+}
+
+var (
+	rulesMu sync.RWMutex
+	rules   = make(map[string]registeredRule)
+)
+
+// RegisterRule registers a user defined assertion under a given name, so it can
+// be applied with Assertion.Rule and Assertion.NotRule, in rule strings, see
+// Compile, and in struct tags, see Assertion.Struct. The default message is a
+// template, see SetMessages, where the parameters are available as {{.Params}},
+// or a format receiving the value followed by the parameters, e.g.
+// "%v is not a valid sku". Messages of a rule registered with a format, as
+// those set with SetMessages or translated, are formats too unless they have
+// placeholders. Registering a name again replaces the previous rule
+func RegisterRule(name string, fn RuleFunc, defaultMsg string) {
+	rulesMu.Lock()
+	defer rulesMu.Unlock()
+
+	rules[name] = registeredRule{fn: fn, defaultMsg: defaultMsg}
+}
+
+// lookupRule returns the rule registered under a given name
+func lookupRule(name string) (registeredRule, bool) {
+	rulesMu.RLock()
+	defer rulesMu.RUnlock()
+
+	r, ok := rules[name]
+	return r, ok
+}
+
+// Rule returns true if a given value satisfies the rule registered under a given
+// name for the given parameters, if any. If the rule is not registered, it
+// records an error wrapping ErrUnknownRule
+func (a *Assertion) Rule(name string, value interface{}, params []interface{}, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		r, ok := lookupRule(name)
+		if !ok {
+			a.addError(fmt.Errorf("%w: %v", ErrUnknownRule, name))
+			return false
+		}
+
+		if r.fn(value, params...) {
+			return true
+		}
+
+		err := NewError(name, value, map[string]interface{}{"Params": params}, msgArgs...)
+		if !hasPlaceholders(r.defaultMsg) {
+			err.args = append([]interface{}{value}, params...)
+		}
+
+		a.addError(err)
+		return false
+	})
+}
+
+// NotRule returns true if a given value does not satisfy the rule registered
+// under a given name for the given parameters, if any. The error of the "not"
+// rule has the name of the negated rule as {{.Name}}
+func (a *Assertion) NotRule(name string, value interface{}, params []interface{}, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		r, ok := lookupRule(name)
+		if !ok {
+			a.addError(fmt.Errorf("%w: %v", ErrUnknownRule, name))
+			return false
+		}
+
+		if !r.fn(value, params...) {
+			return true
+		}
+
+		a.fail("not", value, map[string]interface{}{"Name": name, "Params": params}, msgArgs...)
+		return false
+	})
+}
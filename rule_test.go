@@ -0,0 +1,98 @@
+package assertion
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func init() {
+	sku := regexp.MustCompile(`^[A-Z]{3}-\d{4}$`)
+	RegisterRule("sku", func(value interface{}, params ...interface{}) bool {
+		s, ok := value.(string)
+		return ok && sku.MatchString(s)
+	}, "%v is not a valid sku")
+
+	RegisterRule("prefixed", func(value interface{}, params ...interface{}) bool {
+		s, ok := value.(string)
+		return ok && strings.HasPrefix(s, params[0].(string))
+	}, "%v does not have the prefix %v")
+}
+
+func TestAssertion_Rule_ReturnsTrue(t *testing.T) {
+	a := New()
+
+	assert.True(t, a.Rule("sku", "ABC-1234", nil))
+	assert.True(t, a.Rule("prefixed", "ACC-001", []interface{}{"ACC-"}))
+	assert.False(t, a.HasErrors())
+}
+
+func TestAssertion_Rule_ReturnsFalse(t *testing.T) {
+	a := New()
+
+	assert.False(t, a.Rule("sku", "abc-1234", nil))
+	assert.False(t, a.Rule("sku", 1234, nil))
+	assert.False(t, a.Rule("prefixed", "INT-001", []interface{}{"ACC-"}))
+
+	assert.Equal(t, 3, a.CountErrors())
+	assert.EqualError(t, a.ErrorAt(0), "abc-1234 is not a valid sku")
+	assert.EqualError(t, a.ErrorAt(1), "1234 is not a valid sku")
+	assert.EqualError(t, a.ErrorAt(2), "INT-001 does not have the prefix ACC-")
+}
+
+func TestAssertion_Rule_Unknown(t *testing.T) {
+	a := New()
+
+	assert.False(t, a.Rule("undefined", "value", nil))
+	assert.True(t, errors.Is(a.ErrorAt(0), ErrUnknownRule))
+	assert.EqualError(t, a.ErrorAt(0), "unknown rule: undefined")
+}
+
+func TestAssertion_Rule_Fluent(t *testing.T) {
+	a := New()
+
+	assert.True(t, a.ThatString("sku", "ABC-1234").NotEmpty().Rule("sku").Valid())
+	assert.False(t, a.That("account", "INT-001").Rule("prefixed", "ACC-").Valid())
+	assert.EqualError(t, a.ErrorAt(0), "account: INT-001 does not have the prefix ACC-")
+}
+
+func TestAssertion_Rule_CustomMessage(t *testing.T) {
+	a := New()
+
+	assert.False(t, a.Rule("sku", "abc", nil, "invalid product code"))
+	assert.False(t, a.Rule("prefixed", "INT-001", []interface{}{"ACC-"}, "%s must start with %s", "account", "ACC-"))
+
+	assert.EqualError(t, a.ErrorAt(0), "invalid product code")
+	assert.EqualError(t, a.ErrorAt(1), "account must start with ACC-")
+}
+
+func TestAssertion_NotRule(t *testing.T) {
+	a := New(WithLocale("es"))
+
+	assert.True(t, a.NotRule("sku", "abc-1234", nil))
+	assert.False(t, a.NotRule("prefixed", "TMP-001", []interface{}{"TMP-"}))
+	assert.False(t, a.NotRule("undefined", "value", nil))
+
+	assert.Equal(t, 2, a.CountErrors())
+	assert.EqualError(t, a.ErrorAt(0), "TMP-001 cumple prefixed")
+	assert.True(t, errors.Is(a.ErrorAt(1), ErrUnknownRule))
+}
+
+func TestAssertion_Rule_PercentMessages(t *testing.T) {
+	RegisterRule("testpercent", func(value interface{}, params ...interface{}) bool { return false }, "%v exceeds %v%%")
+	defer SetMessages(map[string]string{"testpercent": "", "between": ""})
+
+	a := New()
+	a.Rule("testpercent", "50%", []interface{}{100})
+	assert.EqualError(t, a.ErrorAt(0), "50% exceeds 100%")
+
+	SetMessages(map[string]string{"testpercent": "{{.Value}} must be < 100%", "between": "{{.Value}} must be within {{.Min}}% and {{.Max}}%"})
+	a.Rule("testpercent", "50%", []interface{}{100})
+	a.Between("7%d", "0", "5")
+
+	assert.EqualError(t, a.ErrorAt(0), "50% must be < 100%")
+	assert.EqualError(t, a.ErrorAt(1), "50% must be < 100%")
+	assert.EqualError(t, a.ErrorAt(2), "7%d must be within 0% and 5%")
+}
@@ -1,84 +1,385 @@
 package assertion
 
 import (
+	"errors"
 	"fmt"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
 	"reflect"
+	"sort"
 	"strings"
 )
 
+// ErrUnhashableKey is the error recorded when a map is searched with a key whose
+// dynamic value cannot be a map key
+var ErrUnhashableKey = errors.New("unhashable key")
+
+// Comparison determines how strings are transformed before being compared. The
+// values may be combined, e.g. CompareFold|CompareNFKC
+type Comparison int
+
+// CompareExact compares strings byte by byte
+const CompareExact Comparison = 0
+
+const (
+	// CompareFold compares strings applying full unicode case folding, so "Straße"
+	// and "STRASSE" are considered equal. Assertions with a Turkic locale, tr or
+	// az, see WithLocale, fold "İ" to "i" and "I" to "ı"
+	CompareFold Comparison = 1 << iota
+	// CompareNFC compares strings in unicode canonical composition form, so
+	// composed and decomposed accents are considered equal
+	CompareNFC
+	// CompareNFKC compares strings in unicode compatibility composition form, so
+	// compatibility variants like "ﬁ" and "fi" are considered equal
+	CompareNFKC
+)
+
+// normalize returns a given string transformed according to the given comparison
+// mode in a given locale. Normalization is applied again after case folding since
+// folding may produce non normalized strings
+func normalize(value string, mode Comparison, locale string) string {
+	form, normalized := norm.NFC, mode&(CompareNFC|CompareNFKC) != 0
+	if mode&CompareNFKC != 0 {
+		form = norm.NFKC
+	}
+
+	if normalized {
+		value = form.String(value)
+	}
+
+	if mode&CompareFold != 0 {
+		value = foldCase(value, locale)
+		if normalized {
+			value = form.String(value)
+		}
+	}
+
+	return value
+}
+
+// foldCase returns a given string with full unicode case folding. Turkic locales
+// lower case the dotted and dotless i first, which plain folding does not tell
+// apart. Casers are stateful, so a new one is used on every call
+func foldCase(value, locale string) string {
+	candidates := localeCandidates(locale)
+	switch candidates[len(candidates)-1] {
+	case "tr", "az":
+		value = cases.Lower(language.Turkish).String(value)
+	}
+
+	return cases.Fold().String(value)
+}
+
 // StartsWith returns true if a given string starts with the given needle substring
 func (a *Assertion) StartsWith(value, needle string, msgArgs ...interface{}) bool {
-	if !strings.HasPrefix(value, needle) {
-		a.addErrorMsg(fmt.Sprintf(errMsgNotStartsWith, value, needle), msgArgs...)
-		return false
-	}
+	return a.eval(func() bool {
+		if !strings.HasPrefix(value, needle) {
+			a.fail("startswith", value, map[string]interface{}{"Needle": needle}, msgArgs...)
+			return false
+		}
 
-	return true
+		return true
+	})
 }
 
 // EndsWith returns true if a given string ends with the given needle substring
 func (a *Assertion) EndsWith(value, needle string, msgArgs ...interface{}) bool {
-	if !strings.HasSuffix(value, needle) {
-		a.addErrorMsg(fmt.Sprintf(errMsgNotEndsWith, value, needle), msgArgs...)
-		return false
-	}
+	return a.eval(func() bool {
+		if !strings.HasSuffix(value, needle) {
+			a.fail("endswith", value, map[string]interface{}{"Needle": needle}, msgArgs...)
+			return false
+		}
 
-	return true
+		return true
+	})
 }
 
 // Contains returns true if a given string ends with the given needle substring
 func (a *Assertion) Contains(value, needle string, msgArgs ...interface{}) bool {
-	if !strings.Contains(value, needle) {
-		a.addErrorMsg(fmt.Sprintf(errMsgNotContains, value, needle), msgArgs...)
-		return false
-	}
+	return a.eval(func() bool {
+		if !strings.Contains(value, needle) {
+			a.fail("contains", value, map[string]interface{}{"Needle": needle}, msgArgs...)
+			return false
+		}
 
-	return true
+		return true
+	})
 }
 
 // StartsWithInsensitive returns true if a given string starts with the given
-// needle substring with insensitive case
+// needle substring with insensitive case. Strings are compared with full unicode
+// case folding in canonical composition form
 func (a *Assertion) StartsWithInsensitive(value, needle string, msgArgs ...interface{}) bool {
-	if !strings.HasPrefix(strings.ToLower(value), strings.ToLower(needle)) {
-		a.addErrorMsg(fmt.Sprintf(errMsgNotStartsWith, value, needle), msgArgs...)
-		return false
-	}
-
-	return true
+	return a.eval(func() bool {
+		return a.StartsWithMode(value, needle, CompareFold|CompareNFC, msgArgs...)
+	})
 }
 
-// EndsWithInsensitive returns true if a given string ends with the given needle substring
+// EndsWithInsensitive returns true if a given string ends with the given needle
+// substring with insensitive case. Strings are compared as in StartsWithInsensitive
 func (a *Assertion) EndsWithInsensitive(value, needle string, msgArgs ...interface{}) bool {
-	if !strings.HasSuffix(strings.ToLower(value), strings.ToLower(needle)) {
-		a.addErrorMsg(fmt.Sprintf(errMsgNotEndsWith, value, needle), msgArgs...)
-		return false
-	}
-
-	return true
+	return a.eval(func() bool {
+		return a.EndsWithMode(value, needle, CompareFold|CompareNFC, msgArgs...)
+	})
 }
 
-// ContainsInsensitive returns true if a given string ends with the given needle substring
+// ContainsInsensitive returns true if a given string contains the given needle
+// substring with insensitive case. Strings are compared as in StartsWithInsensitive
 func (a *Assertion) ContainsInsensitive(value, needle string, msgArgs ...interface{}) bool {
-	if !strings.Contains(strings.ToLower(value), strings.ToLower(needle)) {
-		a.addErrorMsg(fmt.Sprintf(errMsgNotContains, value, needle), msgArgs...)
-		return false
-	}
+	return a.eval(func() bool {
+		return a.ContainsMode(value, needle, CompareFold|CompareNFC, msgArgs...)
+	})
+}
 
-	return true
+// StartsWithMode returns true if a given string starts with the given needle
+// substring when both are compared with the given comparison mode
+func (a *Assertion) StartsWithMode(value, needle string, mode Comparison, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		if !strings.HasPrefix(normalize(value, mode, a.locale), normalize(needle, mode, a.locale)) {
+			a.fail("startswith", value, map[string]interface{}{"Needle": needle}, msgArgs...)
+			return false
+		}
+
+		return true
+	})
+}
+
+// EndsWithMode returns true if a given string ends with the given needle
+// substring when both are compared with the given comparison mode
+func (a *Assertion) EndsWithMode(value, needle string, mode Comparison, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		if !strings.HasSuffix(normalize(value, mode, a.locale), normalize(needle, mode, a.locale)) {
+			a.fail("endswith", value, map[string]interface{}{"Needle": needle}, msgArgs...)
+			return false
+		}
+
+		return true
+	})
+}
+
+// ContainsMode returns true if a given string contains the given needle
+// substring when both are compared with the given comparison mode
+func (a *Assertion) ContainsMode(value, needle string, mode Comparison, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		if !strings.Contains(normalize(value, mode, a.locale), normalize(needle, mode, a.locale)) {
+			a.fail("contains", value, map[string]interface{}{"Needle": needle}, msgArgs...)
+			return false
+		}
+
+		return true
+	})
 }
 
 // HasKey returns true if a given key exists on the a given map
 func (a *Assertion) HasKey(value interface{}, key interface{}, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		v := reflect.ValueOf(value)
+		if v.Kind() == reflect.Map {
+			_, ok, err := mapIndex(v, key)
+			if err != nil {
+				a.addError(err)
+				return false
+			}
+			if ok {
+				return true
+			}
+		}
 
-	v := reflect.ValueOf(value)
-	if v.Kind() == reflect.Map {
+		a.fail("haskey", value, map[string]interface{}{"Key": key}, msgArgs...)
+		return false
+	})
+}
+
+// HasKeys returns true if all the given keys exist on a given map. Its error
+// message is customized with SetMessages, since keys are variadic
+func (a *Assertion) HasKeys(value interface{}, keys ...interface{}) bool {
+	return a.eval(func() bool {
+		v := reflect.ValueOf(value)
+		if v.Kind() != reflect.Map {
+			a.fail("haskeys", value, map[string]interface{}{"Keys": keys})
+			return false
+		}
+
+		missing := make([]interface{}, 0)
+		for _, key := range keys {
+			_, ok, err := mapIndex(v, key)
+			if err != nil {
+				a.addError(err)
+				return false
+			}
+			if !ok {
+				missing = append(missing, key)
+			}
+		}
+
+		if len(missing) > 0 {
+			a.fail("haskeys", value, map[string]interface{}{"Keys": missing})
+			return false
+		}
+
+		return true
+	})
+}
+
+// HasOnlyKeys returns true if a given map has no keys other than the given
+// allowed keys. Not every allowed key is required to exist on the map
+func (a *Assertion) HasOnlyKeys(value interface{}, keys interface{}, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		v := reflect.ValueOf(value)
+		if v.Kind() != reflect.Map {
+			a.fail("hasonlykeys", value, map[string]interface{}{"Keys": keys}, msgArgs...)
+			return false
+		}
+
+		allowed := make(map[interface{}]struct{})
+		for _, k := range toSlice(keys) {
+			if hashable(k) {
+				allowed[k] = struct{}{}
+			}
+		}
+
+		unexpected := make([]interface{}, 0)
+		for _, k := range v.MapKeys() {
+			if _, ok := allowed[k.Interface()]; !ok {
+				unexpected = append(unexpected, k.Interface())
+			}
+		}
+
+		if len(unexpected) > 0 {
+			sortValues(unexpected)
+			a.fail("hasonlykeys", value, map[string]interface{}{"Keys": unexpected}, msgArgs...)
+			return false
+		}
+
+		return true
+	})
+}
+
+// HasValue returns true if a given element exists as a value on a given map
+func (a *Assertion) HasValue(value interface{}, element interface{}, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		v := reflect.ValueOf(value)
+		if v.Kind() == reflect.Map {
+			iter := v.MapRange()
+			for iter.Next() {
+				if reflect.DeepEqual(iter.Value().Interface(), element) {
+					return true
+				}
+			}
+		}
+
+		a.fail("hasvalue", value, map[string]interface{}{"Element": element}, msgArgs...)
+		return false
+	})
+}
+
+// KeyMatches returns true if every key of a given map matches the given regular
+// expression pattern, which may be a string or a compiled *regexp.Regexp. Non
+// string keys are matched against their default format
+func (a *Assertion) KeyMatches(value interface{}, pattern interface{}, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		rex, err := compilePattern(pattern)
+		if err != nil {
+			a.addError(err)
+			return false
+		}
+
+		v := reflect.ValueOf(value)
+		if v.Kind() != reflect.Map {
+			a.fail("keymatches", value, map[string]interface{}{"Keys": value, "Pattern": rex}, msgArgs...)
+			return false
+		}
+
+		unmatched := make([]interface{}, 0)
 		for _, k := range v.MapKeys() {
-			if reflect.DeepEqual(k.Interface(), key){
+			if !rex.MatchString(fmt.Sprint(k.Interface())) {
+				unmatched = append(unmatched, k.Interface())
+			}
+		}
+
+		if len(unmatched) > 0 {
+			sortValues(unmatched)
+			a.fail("keymatches", value, map[string]interface{}{"Keys": unmatched, "Pattern": rex}, msgArgs...)
+			return false
+		}
+
+		return true
+	})
+}
+
+// HasKeyWithValue returns true if a given key exists on a given map and its
+// value is deeply equal to the given element
+func (a *Assertion) HasKeyWithValue(value interface{}, key interface{}, element interface{}, msgArgs ...interface{}) bool {
+	return a.eval(func() bool {
+		v := reflect.ValueOf(value)
+		if v.Kind() == reflect.Map {
+			e, ok, err := mapIndex(v, key)
+			if err != nil {
+				a.addError(err)
+				return false
+			}
+			if ok && reflect.DeepEqual(e.Interface(), element) {
 				return true
 			}
 		}
+
+		a.fail("haskeyvalue", value, map[string]interface{}{"Key": key, "Element": element}, msgArgs...)
+		return false
+	})
+}
+
+// mapIndex returns the value stored under a given key on a given map value and
+// whether the key exists. It returns an ErrUnhashableKey error if the dynamic
+// value of the key is not comparable, which would make the lookup panic
+func mapIndex(m reflect.Value, key interface{}) (reflect.Value, bool, error) {
+	if !hashable(key) {
+		return reflect.Value{}, false, fmt.Errorf("%w: %v of type %T", ErrUnhashableKey, key, key)
+	}
+
+	keyType := m.Type().Key()
+	k := reflect.ValueOf(key)
+	if !k.IsValid() {
+		if keyType.Kind() != reflect.Interface {
+			return reflect.Value{}, false, nil
+		}
+		k = reflect.Zero(keyType)
+	}
+
+	if !k.Type().AssignableTo(keyType) {
+		return reflect.Value{}, false, nil
+	}
+
+	e := m.MapIndex(k)
+	return e, e.IsValid(), nil
+}
+
+// hashable returns true if a given value may be used as a map key
+func hashable(value interface{}) bool {
+	v := reflect.ValueOf(value)
+	return !v.IsValid() || v.Comparable()
+}
+
+// toSlice returns the elements of a given slice or array as a slice of
+// interfaces. Any other value is returned as a single element slice
+func toSlice(values interface{}) []interface{} {
+	v := reflect.ValueOf(values)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return []interface{}{values}
 	}
 
-	a.addErrorMsg(fmt.Sprintf(errMsgNotHasKey, value, key), msgArgs...)
-	return false
+	s := make([]interface{}, v.Len())
+	for i := range s {
+		s[i] = v.Index(i).Interface()
+	}
+
+	return s
+}
+
+// sortValues sorts in place the given values by their default format, so
+// elements collected from map iterations are reported deterministically
+func sortValues(values []interface{}) {
+	sort.Slice(values, func(i, j int) bool {
+		return fmt.Sprint(values[i]) < fmt.Sprint(values[j])
+	})
 }
@@ -1,6 +1,9 @@
 package assertion
 
 import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"regexp"
 	"testing"
 )
 
@@ -120,6 +123,9 @@ func TestAssertion_ContainsInsensitive_ReturnsFalse(t *testing.T) {
 	assertAllReturnsFalse(t, data)
 }
 
+// unhashableKey has a comparable type but a dynamic value that is not
+var unhashableKey = struct{ Value interface{} }{[]string{"a"}}
+
 func TestAssertion_HasKey_ReturnsTrue(t *testing.T) {
 	data := []MethodDataOK{
 		{"HasKey", []interface{}{map[string]string{"a": "a"}, "a"}},
@@ -138,3 +144,214 @@ func TestAssertion_HasKey_ReturnsFalse(t *testing.T) {
 
 	assertAllReturnsFalse(t, data)
 }
+
+func TestAssertion_HasKey_UnhashableKey(t *testing.T) {
+	a := New()
+
+	assert.False(t, a.HasKey(map[interface{}]string{"a": "a"}, unhashableKey))
+	assert.False(t, a.HasKeyWithValue(map[interface{}]string{"a": "a"}, []string{"a"}, "a"))
+
+	assert.Equal(t, 2, a.CountErrors())
+	assert.True(t, errors.Is(a.ErrorAt(0), ErrUnhashableKey))
+	assert.EqualError(t, a.ErrorAt(0), "unhashable key: {[a]} of type struct { Value interface {} }")
+	assert.EqualError(t, a.ErrorAt(1), "unhashable key: [a] of type []string")
+}
+
+func TestAssertion_HasKeys_ReturnsTrue(t *testing.T) {
+	data := []struct {
+		value interface{}
+		keys  []interface{}
+	}{
+		{map[string]string{"a": "a", "b": "b"}, []interface{}{"a", "b"}},
+		{map[string]string{"a": "a", "b": "b"}, []interface{}{"a"}},
+		{map[interface{}]string{1: "a", "b": "b"}, []interface{}{1, "b"}},
+		{map[interface{}]string{nil: "a"}, []interface{}{nil}},
+		{map[string]string{}, nil},
+	}
+
+	for _, d := range data {
+		a := New()
+		assert.True(t, a.HasKeys(d.value, d.keys...), d.keys)
+		assert.False(t, a.HasErrors(), d.keys)
+	}
+}
+
+func TestAssertion_HasKeys_ReturnsFalse(t *testing.T) {
+	data := []struct {
+		value  interface{}
+		keys   []interface{}
+		errMsg string
+	}{
+		{"Hello world!", []interface{}{"a"}, "Hello world! has not the keys [a]"},
+		{"Hello world!", nil, "Hello world! has not the keys []"},
+		{nil, nil, "<nil> has not the keys []"},
+		{map[string]string{"a": "a"}, []interface{}{"a", "b", "c"}, "map[a:a] has not the keys [b c]"},
+		{map[int]string{1: "a"}, []interface{}{int64(1)}, "map[1:a] has not the keys [1]"},
+		{map[string]string{"a": "a"}, []interface{}{[]string{"a"}}, "unhashable key: [a] of type []string"},
+		{map[interface{}]string{"a": "a"}, []interface{}{unhashableKey}, "unhashable key: {[a]} of type struct { Value interface {} }"},
+	}
+
+	for _, d := range data {
+		a := New()
+		assert.False(t, a.HasKeys(d.value, d.keys...), d.keys)
+		assert.Equal(t, 1, a.CountErrors(), d.keys)
+		assert.EqualError(t, a.ErrorAt(0), d.errMsg, d.keys)
+	}
+}
+
+func TestAssertion_HasOnlyKeys_ReturnsTrue(t *testing.T) {
+	data := []MethodDataOK{
+		{"HasOnlyKeys", []interface{}{map[string]string{"a": "a", "b": "b"}, []string{"a", "b"}}},
+		{"HasOnlyKeys", []interface{}{map[string]string{"a": "a"}, []string{"a", "b"}}},
+		{"HasOnlyKeys", []interface{}{map[string]string{}, []string{"a"}}},
+		{"HasOnlyKeys", []interface{}{map[interface{}]string{1: "a"}, []interface{}{[]int{1}, 1}}},
+	}
+
+	assertAllReturnsTrue(t, data)
+}
+
+func TestAssertion_HasOnlyKeys_ReturnsFalse(t *testing.T) {
+	data := []MethodDataKO{
+		{"HasOnlyKeys", []interface{}{"Hello world!", []string{"a"}}, "Hello world! has unexpected keys [a]"},
+		{"HasOnlyKeys", []interface{}{map[string]string{"a": "a", "d": "d", "c": "c"}, []string{"a", "b"}}, "map[a:a c:c d:d] has unexpected keys [c d]"},
+	}
+
+	assertAllReturnsFalse(t, data)
+}
+
+func TestAssertion_HasValue_ReturnsTrue(t *testing.T) {
+	data := []MethodDataOK{
+		{"HasValue", []interface{}{map[string]string{"a": "x", "b": "y"}, "y"}},
+		{"HasValue", []interface{}{map[int][]int{1: {1, 2}}, []int{1, 2}}},
+	}
+
+	assertAllReturnsTrue(t, data)
+}
+
+func TestAssertion_HasValue_ReturnsFalse(t *testing.T) {
+	data := []MethodDataKO{
+		{"HasValue", []interface{}{"Hello world!", "H"}, "Hello world! has not the value H"},
+		{"HasValue", []interface{}{map[string]string{"a": "x"}, "a"}, "map[a:x] has not the value a"},
+	}
+
+	assertAllReturnsFalse(t, data)
+}
+
+func TestAssertion_KeyMatches_ReturnsTrue(t *testing.T) {
+	data := []MethodDataOK{
+		{"KeyMatches", []interface{}{map[string]int{"db_host": 1, "db_port": 2}, `^db_[a-z]+$`}},
+		{"KeyMatches", []interface{}{map[int]int{10: 1, 20: 2}, regexp.MustCompile(`^\d0$`)}},
+	}
+
+	assertAllReturnsTrue(t, data)
+}
+
+func TestAssertion_KeyMatches_ReturnsFalse(t *testing.T) {
+	data := []MethodDataKO{
+		{"KeyMatches", []interface{}{"Hello world!", regexp.MustCompile(`^H`)}, "Hello world! has keys Hello world! not matching ^H"},
+		{"KeyMatches", []interface{}{map[string]int{"db_host": 1, "Port": 2, "Host": 3}, `^db_[a-z]+$`}, "map[Host:3 Port:2 db_host:1] has keys [Host Port] not matching ^db_[a-z]+$"},
+	}
+
+	assertAllReturnsFalse(t, data)
+}
+
+func TestAssertion_HasKeyWithValue_ReturnsTrue(t *testing.T) {
+	data := []MethodDataOK{
+		{"HasKeyWithValue", []interface{}{map[string]string{"a": "x"}, "a", "x"}},
+		{"HasKeyWithValue", []interface{}{map[string]interface{}{"a": []int{1}}, "a", []int{1}}},
+	}
+
+	assertAllReturnsTrue(t, data)
+}
+
+func TestAssertion_HasKeyWithValue_ReturnsFalse(t *testing.T) {
+	data := []MethodDataKO{
+		{"HasKeyWithValue", []interface{}{"Hello world!", "a", "x"}, "Hello world! has not the key a with value x"},
+		{"HasKeyWithValue", []interface{}{map[string]string{"a": "x"}, "a", "y"}, "map[a:x] has not the key a with value y"},
+		{"HasKeyWithValue", []interface{}{map[string]string{"a": "x"}, "b", "x"}, "map[a:x] has not the key b with value x"},
+	}
+
+	assertAllReturnsFalse(t, data)
+}
+
+func TestAssertion_Insensitive_FullCaseFolding(t *testing.T) {
+	data := []MethodDataOK{
+		{"StartsWithInsensitive", []interface{}{"Straße", "STRASS"}},
+		{"EndsWithInsensitive", []interface{}{"Gruß", "USS"}},
+		{"ContainsInsensitive", []interface{}{"Jose\u0301", "JOS\u00c9"}},
+		{"StartsWithInsensitive", []interface{}{"Σοφία", "σο"}},
+	}
+
+	assertAllReturnsTrue(t, data)
+}
+
+func TestAssertion_Insensitive_TurkicCaseFolding(t *testing.T) {
+	a := New()
+	assert.True(t, a.EqualString("KIŞ", "kiş", CompareFold))
+	assert.False(t, a.EqualString("İstanbul", "istanbul", CompareFold))
+
+	for _, locale := range []string{"tr", "tr-TR", "az"} {
+		a := New(WithLocale(locale))
+		assert.True(t, a.EqualString("İstanbul", "istanbul", CompareFold), locale)
+		assert.True(t, a.StartsWithInsensitive("KIŞ", "kı"), locale)
+		assert.False(t, a.StartsWithInsensitive("KIŞ", "ki"), locale)
+		assert.True(t, a.ContainsInsensitive("DİYARBAKIR", "diyarbakır"), locale)
+		assert.Equal(t, 1, a.CountErrors(), locale)
+	}
+}
+
+func TestAssertion_StartsWithMode_ReturnsTrue(t *testing.T) {
+	data := []MethodDataOK{
+		{"StartsWithMode", []interface{}{"Hello world!", "Hello", CompareExact}},
+		{"StartsWithMode", []interface{}{"Straße", "STRASS", CompareFold}},
+		{"StartsWithMode", []interface{}{"e\u0301cole", "\u00e9", CompareNFC}},
+		{"StartsWithMode", []interface{}{"ﬁle", "fi", CompareNFKC}},
+		{"StartsWithMode", []interface{}{"ﬁle", "FI", CompareNFKC | CompareFold}},
+	}
+
+	assertAllReturnsTrue(t, data)
+}
+
+func TestAssertion_StartsWithMode_ReturnsFalse(t *testing.T) {
+	data := []MethodDataKO{
+		{"StartsWithMode", []interface{}{"Straße", "STRASS", CompareExact}, "Straße does not start with STRASS"},
+		{"StartsWithMode", []interface{}{"e\u0301cole", "\u00e9", CompareFold}, "e\u0301cole does not start with \u00e9"},
+		{"StartsWithMode", []interface{}{"ﬁle", "fi", CompareNFC}, "ﬁle does not start with fi"},
+	}
+
+	assertAllReturnsFalse(t, data)
+}
+
+func TestAssertion_EndsWithMode_ReturnsTrue(t *testing.T) {
+	data := []MethodDataOK{
+		{"EndsWithMode", []interface{}{"cafe\u0301", "\u00e9", CompareNFC}},
+		{"EndsWithMode", []interface{}{"GRÜSSE", "üße", CompareFold}},
+	}
+
+	assertAllReturnsTrue(t, data)
+}
+
+func TestAssertion_EndsWithMode_ReturnsFalse(t *testing.T) {
+	data := []MethodDataKO{
+		{"EndsWithMode", []interface{}{"cafe\u0301", "\u00e9", CompareExact}, "cafe\u0301 does not end with \u00e9"},
+	}
+
+	assertAllReturnsFalse(t, data)
+}
+
+func TestAssertion_ContainsMode_ReturnsTrue(t *testing.T) {
+	data := []MethodDataOK{
+		{"ContainsMode", []interface{}{"René Magritte", "RENÉ", CompareFold | CompareNFC}},
+		{"ContainsMode", []interface{}{"x²", "2", CompareNFKC}},
+	}
+
+	assertAllReturnsTrue(t, data)
+}
+
+func TestAssertion_ContainsMode_ReturnsFalse(t *testing.T) {
+	data := []MethodDataKO{
+		{"ContainsMode", []interface{}{"x²", "2", CompareNFC}, "x² does not contain 2"},
+	}
+
+	assertAllReturnsFalse(t, data)
+}
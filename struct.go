@@ -0,0 +1,95 @@
+package assertion
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+const errMsgNotStruct = `%T is not a struct or a pointer to a struct`
+
+// structField is an exported field of a struct type asserted by Struct
+type structField struct {
+	index int
+	name  string
+	rule  *CompiledRule
+	err   error
+}
+
+// structFields caches the fields of the struct types asserted by Struct
+var structFields sync.Map
+
+// Struct returns true if the exported fields of a given struct, or pointer to
+// a struct, satisfy the rule strings of their rule tag, see Compile, e.g.
+//
+//	type User struct {
+//		Email string `json:"email" rule:"required,email"`
+//		SKU   string `rule:"omitempty,sku,!startswith=TMP"`
+//	}
+//
+// Errors are prefixed by the field name, taken from the json tag if any. Nested
+// structs and pointers to structs are asserted too, with their errors prefixed
+// by the path of the field, e.g. "address.city". An invalid rule tag is
+// recorded as its *SyntaxError. It panics if v is not a struct
+func (a *Assertion) Struct(v interface{}) bool {
+	return a.eval(func() bool {
+		rv := reflect.Indirect(reflect.ValueOf(v))
+		if rv.Kind() != reflect.Struct {
+			panic(fmt.Errorf(errMsgNotStruct, v))
+		}
+
+		ok := true
+		for _, f := range fieldsOf(rv.Type()) {
+			if f.err != nil {
+				a.addError(f.err)
+				ok = false
+				continue
+			}
+
+			fv := rv.Field(f.index)
+			chain := a.That(f.name, fv.Interface())
+			if f.rule != nil {
+				chain.Check(func(a *Assertion) bool { return a.Apply(f.rule, fv.Interface()) })
+			}
+
+			if nested := reflect.Indirect(fv); nested.Kind() == reflect.Struct {
+				chain.Check(func(a *Assertion) bool { return a.Struct(nested.Interface()) })
+			}
+
+			ok = chain.Valid() && ok
+		}
+
+		return ok
+	})
+}
+
+// fieldsOf returns the exported fields of a given struct type, with their rule
+// tags compiled
+func fieldsOf(rt reflect.Type) []structField {
+	if fields, ok := structFields.Load(rt); ok {
+		return fields.([]structField)
+	}
+
+	fields := make([]structField, 0, rt.NumField())
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		sf := structField{index: i, name: f.Name}
+		if name, _, _ := strings.Cut(f.Tag.Get("json"), ","); name != "" && name != "-" {
+			sf.name = name
+		}
+
+		if source, ok := f.Tag.Lookup("rule"); ok {
+			sf.rule, sf.err = Compile(source)
+		}
+
+		fields = append(fields, sf)
+	}
+
+	structFields.Store(rt, fields)
+	return fields
+}
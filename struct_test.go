@@ -0,0 +1,66 @@
+package assertion
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type testAddress struct {
+	City    string `json:"city" rule:"required,letters"`
+	Country string `json:"country,omitempty" rule:"oneof=ES FR"`
+}
+
+type testUser struct {
+	Email    string       `json:"email" rule:"required,email"`
+	SKU      string       `rule:"omitempty,uppercase,!startswith=TMP"`
+	Age      int          `json:"age" rule:"between=18,150"`
+	Address  testAddress  `json:"address"`
+	Billing  *testAddress `json:"billing"`
+	Ignored  string       `json:"-" rule:"required"`
+	internal string       `rule:"required"`
+}
+
+func TestAssertion_Struct(t *testing.T) {
+	a := New()
+
+	user := testUser{Email: "test@mail.com", SKU: "ABC-1234", Age: 30, Address: testAddress{City: "Madrid", Country: "ES"}, Ignored: "x"}
+	assert.True(t, a.Struct(user))
+	assert.True(t, a.Struct(&user))
+	assert.False(t, a.HasErrors())
+}
+
+func TestAssertion_Struct_ReturnsFalse(t *testing.T) {
+	a := New(WithLocale("fr"))
+
+	user := testUser{
+		Email:   "foo",
+		SKU:     "TMP-0001",
+		Age:     10,
+		Address: testAddress{City: "Madrid 2", Country: "ES"},
+		Billing: &testAddress{Country: "PT"},
+		Ignored: "x",
+	}
+	assert.False(t, a.Struct(&user))
+
+	assert.EqualError(t, a.Err(), "email: foo n'est pas un email valide; "+
+		"SKU: TMP-0001 satisfait startswith; "+
+		"age: 10 n'est pas entre 18 et 150; "+
+		"address.city: Madrid 2 ne contient pas que des lettres; "+
+		"billing.city: la valeur est vide; "+
+		"billing.country: PT n'est pas l'un de [ES FR]")
+}
+
+func TestAssertion_Struct_InvalidRule(t *testing.T) {
+	a := New()
+
+	assert.False(t, a.Struct(struct {
+		Name string `rule:"required,emial"`
+	}{"foo"}))
+
+	var syntaxErr *SyntaxError
+	assert.True(t, errors.As(a.ErrorAt(0), &syntaxErr))
+	assert.Equal(t, 9, syntaxErr.Offset)
+
+	assert.Panics(t, func() { a.Struct("foo") })
+}
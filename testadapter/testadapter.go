@@ -0,0 +1,82 @@
+// Package testadapter reports assertion failures as test errors, so the same
+// assertions used to validate domain data can be used directly in tests.
+package testadapter
+
+import (
+	"fmt"
+	"github.com/sangarbe/assertion"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// pkgPath is the import path of the assertion package. Frames of functions
+// under this path are skipped when looking for the failing caller
+var pkgPath = reflect.TypeOf(assertion.Assertion{}).PkgPath()
+
+// NewT creates and returns a new Assertion that reports each failure to the given
+// test with t.Errorf, prefixed by the file:line of the failing assertion call.
+// The test goes on after a failure. The file:line that the testing package adds
+// itself is the one of the assertion package, which cannot be marked as helper
+func NewT(t testing.TB) assertion.Assertion {
+	t.Helper()
+
+	return assertion.New(assertion.WithErrorHandler(func(err error) {
+		t.Errorf("%s: %v", caller(), err)
+	}))
+}
+
+// NewRequire creates and returns a new Assertion that reports each failure to
+// the given test as NewT does, and then stops the test with t.FailNow. It must
+// be called from the test goroutine. Failures of the functions run with Go or
+// Async are reported by Wait, so the test is stopped by the goroutine calling
+// Wait. Failures recorded from any other goroutine are only reported, since
+// t.FailNow must not be called outside the test goroutine
+func NewRequire(t testing.TB) assertion.Assertion {
+	t.Helper()
+
+	test := goroutine()
+	return assertion.New(assertion.WithErrorHandler(func(err error) {
+		t.Errorf("%s: %v", caller(), err)
+		if goroutine() == test {
+			t.FailNow()
+		}
+	}))
+}
+
+// goroutine returns the id of the current goroutine, read from the header of its
+// stack trace, e.g. "goroutine 7 [running]:"
+func goroutine() string {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	id, _, _ := strings.Cut(strings.TrimPrefix(string(buf), "goroutine "), " ")
+
+	return id
+}
+
+// caller returns the file:line of the first frame of the call stack outside of
+// the assertion module, which is the test code calling the failing assertion.
+// Test files of the assertion packages themselves are not skipped
+func caller() string {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(2, pcs)
+	for n == len(pcs) {
+		pcs = make([]uintptr, 2*len(pcs))
+		n = runtime.Callers(2, pcs)
+	}
+
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		internal := strings.HasPrefix(frame.Function, pkgPath+".") || strings.HasPrefix(frame.Function, pkgPath+"/")
+		if !internal || strings.HasSuffix(frame.File, "_test.go") {
+			return fmt.Sprintf("%s:%d", filepath.Base(frame.File), frame.Line)
+		}
+
+		if !more {
+			return "???:1"
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package testadapter
+
+import (
+	"fmt"
+	"github.com/sangarbe/assertion"
+	"github.com/stretchr/testify/assert"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+// fakeT records the calls made by the adapters instead of failing the test
+type fakeT struct {
+	testing.TB
+	errors  []string
+	stopped bool
+}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Errorf(format string, args ...interface{}) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeT) FailNow() {
+	f.stopped = true
+}
+
+// line returns the line number of its caller
+func line() int {
+	_, _, l, _ := runtime.Caller(1)
+	return l
+}
+
+func TestNewT(t *testing.T) {
+	ft := &fakeT{}
+	a := NewT(ft)
+
+	assert.True(t, a.Email("test@mail.com"))
+	l := line() + 1
+	assert.False(t, a.Email("plainaddress"))
+	assert.False(t, a.GreaterThan(1, 2))
+
+	assert.Equal(t, 2, a.CountErrors())
+	assert.Len(t, ft.errors, 2)
+	assert.Equal(t, fmt.Sprintf("testadapter_test.go:%d: plainaddress is not a valid email", l), ft.errors[0])
+	assert.Equal(t, fmt.Sprintf("testadapter_test.go:%d: 1 is not greater than 2", l+1), ft.errors[1])
+	assert.False(t, ft.stopped)
+}
+
+func TestNewRequire(t *testing.T) {
+	ft := &fakeT{}
+	a := NewRequire(ft)
+
+	assert.True(t, a.Ipv4("127.0.0.1"))
+	assert.False(t, ft.stopped)
+
+	assert.False(t, a.Ipv4("256.0.0.1"))
+	assert.Len(t, ft.errors, 1)
+	assert.Contains(t, ft.errors[0], "testadapter_test.go:")
+	assert.Contains(t, ft.errors[0], "256.0.0.1 is not a valid ipv4")
+	assert.True(t, ft.stopped)
+}
+
+func TestNewRequire_Go(t *testing.T) {
+	ft := &fakeT{}
+	a := NewRequire(ft)
+
+	a.Go(func(a *assertion.Assertion) {
+		a.Ipv4("256.0.0.1")
+	})
+	assert.False(t, ft.stopped)
+
+	assert.False(t, a.Wait())
+	assert.Len(t, ft.errors, 1)
+	assert.True(t, ft.stopped)
+}
+
+func TestNewRequire_OtherGoroutine(t *testing.T) {
+	ft := &fakeT{}
+	a := NewRequire(ft)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		a.Ipv4("256.0.0.1")
+	}()
+	wg.Wait()
+
+	assert.Len(t, ft.errors, 1)
+	assert.False(t, ft.stopped)
+}
+
+func TestNewT_NestedCaller(t *testing.T) {
+	ft := &fakeT{}
+	a := NewT(ft)
+
+	type address struct {
+		City string `json:"city" rule:"required"`
+	}
+	type user struct {
+		Address address `json:"address"`
+	}
+
+	l := line() + 1
+	assert.False(t, a.Struct(user{}))
+	assert.Equal(t, []string{fmt.Sprintf("testadapter_test.go:%d: address.city: value is empty", l)}, ft.errors)
+}